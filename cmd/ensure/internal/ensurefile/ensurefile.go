@@ -36,6 +36,15 @@ const ExampleFile = `mocks:
   # Optional, defaults to false.
   disableEnhancedMatcherFailures: false
 
+  # Enable diffs in enhanced matcher failure messages.
+  # When enabled, enhanced matcher failures include a diff of the mismatched argument,
+  # using the same format as ensure(...).Equals(...), and explain why ensuring matchers did
+  # not match. This requires a version of ensure that provides ensuring.Diff and
+  # ensuring.Matcher, so only enable it once the module uses that version.
+  # Ignored when disableEnhancedMatcherFailures is true.
+  # Optional, defaults to false.
+  enableEnhancedMatcherDiffs: false
+
   # Packages with interfaces for which to generate mocks
   packages:
     - path: github.com/my/app/some/pkg
@@ -85,6 +94,7 @@ type MockConfig struct {
 	RawTidyAfterGenerate *bool `yaml:"tidyAfterGenerate"`
 
 	DisableEnhancedMatcherFailures bool `yaml:"disableEnhancedMatcherFailures"`
+	EnableEnhancedMatcherDiffs     bool `yaml:"enableEnhancedMatcherDiffs"`
 
 	Packages []*MockPackage `yaml:"packages"`
 }
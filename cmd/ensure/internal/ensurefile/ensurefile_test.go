@@ -100,6 +100,43 @@ mocks:
       interfaces:
         - Iface1
         - Iface2
+`,
+			}.setupMocks,
+		},
+		{
+			Name: "with valid config and enhanced matcher diffs enabled",
+			PWD:  defaultRootPath,
+			ExpectedConfig: &ensurefile.Config{
+				RootPath:   defaultRootPath,
+				ModulePath: defaultModulePath,
+				Mocks: &ensurefile.MockConfig{
+					PrimaryDestination:         "internal/mocks",
+					InternalDestination:        "mocks",
+					RawTidyAfterGenerate:       boolPtr(true),
+					TidyAfterGenerate:          true,
+					EnableEnhancedMatcherDiffs: true,
+					Packages: []*ensurefile.MockPackage{
+						{
+							Path: defaultModulePath + "/some/pkg",
+							Interfaces: []string{
+								"Iface1",
+								"Iface2",
+							},
+						},
+					},
+				},
+			},
+
+			SetupMocks: mapFS{
+				"my/app/go.mod": defaultGoModFile,
+				"my/app/.ensure.yml": `
+mocks:
+  enableEnhancedMatcherDiffs: true
+  packages:
+    - path: github.com/my/app/some/pkg
+      interfaces:
+        - Iface1
+        - Iface2
 `,
 			}.setupMocks,
 		},
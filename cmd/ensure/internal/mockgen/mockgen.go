@@ -65,6 +65,11 @@ func (g *MockGen) generateMock(pkg *ifacereader.Package, importsPkg *uniqpkg.Pac
 		prettyImport = importsPkg.AddImport("github.com/kr/pretty", "pretty")
 	}
 
+	var ensuringImport *uniqpkg.ImportDetails
+	if !config.DisableEnhancedMatcherFailures && config.EnableEnhancedMatcherDiffs {
+		ensuringImport = importsPkg.AddImport("github.com/JosiahWitt/ensure/ensuring", "ensuring")
+	}
+
 	params := &templateParams{
 		Package: pkg,
 		Imports: importsPkg.Imports(),
@@ -73,12 +78,17 @@ func (g *MockGen) generateMock(pkg *ifacereader.Package, importsPkg *uniqpkg.Pac
 		GoMockPackageName:  goMockImport.Name,
 
 		EnableEnhancedMatcherFailures: !config.DisableEnhancedMatcherFailures,
+		EnableEnhancedMatcherDiffs:    ensuringImport != nil,
 	}
 
 	if params.EnableEnhancedMatcherFailures {
 		params.PrettyPackageName = prettyImport.Name
 	}
 
+	if params.EnableEnhancedMatcherDiffs {
+		params.EnsuringPackageName = ensuringImport.Name
+	}
+
 	var writer bytes.Buffer
 	if err := g.tmpl.Execute(&writer, params); err != nil {
 		return nil, err // Shouldn't be possible, since the parameters are controlled within this package
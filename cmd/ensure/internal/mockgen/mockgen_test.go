@@ -10,6 +10,7 @@ import (
 	"github.com/JosiahWitt/ensure/cmd/ensure/internal/ensurefile"
 	"github.com/JosiahWitt/ensure/cmd/ensure/internal/ifacereader"
 	"github.com/JosiahWitt/ensure/cmd/ensure/internal/mockgen"
+	"github.com/JosiahWitt/ensure/cmd/ensure/internal/mockgen/scenarios/enhanced_matcher_diffs_enabled"
 	"github.com/JosiahWitt/ensure/cmd/ensure/internal/mockgen/scenarios/enhanced_matcher_failures_disabled"
 	"github.com/JosiahWitt/ensure/cmd/ensure/internal/mockgen/scenarios/generics_multiple_type_params"
 	"github.com/JosiahWitt/ensure/cmd/ensure/internal/mockgen/scenarios/generics_single_type_param"
@@ -282,6 +283,24 @@ func TestGenerateMocks(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "with enhanced matcher diffs enabled",
+
+			InputPackages: []*ifacereader.Package{
+				enhanced_matcher_diffs_enabled.Package,
+			},
+			Config: &ensurefile.MockConfig{
+				EnableEnhancedMatcherDiffs: true,
+			},
+
+			ExpectedPackageMocks: []*mockgen.PackageMock{
+				{
+					Package: enhanced_matcher_diffs_enabled.Package,
+
+					FileContents: readExpectationFile("enhanced_matcher_diffs_enabled", "pkg1"),
+				},
+			},
+		},
 	}
 
 	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
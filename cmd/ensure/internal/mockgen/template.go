@@ -16,7 +16,9 @@ type templateParams struct {
 	ReflectPackageName            string
 	GoMockPackageName             string
 	PrettyPackageName             string
+	EnsuringPackageName           string
 	EnableEnhancedMatcherFailures bool
+	EnableEnhancedMatcherDiffs    bool
 }
 
 //nolint:gochecknoglobals // Only read internally
@@ -99,6 +101,16 @@ func (mr *{{buildMockRecorderStructName $iface}}) {{$method.Name}}({{buildMockIn
 {{end -}}
 {{if $params.EnableEnhancedMatcherFailures}}
 func wrapMatcher(input interface{}) {{$params.GoMockPackageName}}.Matcher {
+	{{- if $params.EnableEnhancedMatcherDiffs}}
+	if matcher, ok := input.({{$params.EnsuringPackageName}}.Matcher); ok {
+		return {{$params.GoMockPackageName}}.GotFormatterAdapter(
+			{{$params.GoMockPackageName}}.GotFormatterFunc(func(got interface{}) string {
+				return {{$params.PrettyPackageName}}.Sprint(got) + "\nMismatch:\n" + matcher.Explain(got)
+			}),
+			matcher,
+		)
+	}
+{{end}}
 	if matcher, ok := input.({{$params.GoMockPackageName}}.Matcher); ok {
 		return matcher
 	}
@@ -119,7 +131,16 @@ func wrapMatcher(input interface{}) {{$params.GoMockPackageName}}.Matcher {
 
 	return {{$params.GoMockPackageName}}.GotFormatterAdapter(
 		{{$params.GoMockPackageName}}.GotFormatterFunc(func(got interface{}) string {
+			{{- if $params.EnableEnhancedMatcherDiffs}}
+			formatted := {{$params.PrettyPackageName}}.Sprint(got)
+			if diff := {{$params.EnsuringPackageName}}.Diff(got, input); diff != "" {
+				formatted += "\nDiff:\n" + diff
+			}
+
+			return formatted
+			{{- else}}
 			return {{$params.PrettyPackageName}}.Sprint(got)
+			{{- end}}
 		}),
 		matcher,
 	)
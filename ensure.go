@@ -1,5 +1,5 @@
 // Package ensure is a balanced testing framework for Go 1.14+.
-// It supports modern Go 1.13+ error comparisons (via errors.Is), and provides easy to read diffs (via deep.Equal).
+// It supports modern Go 1.13+ error comparisons (via errors.Is), and provides easy to read diffs.
 //
 // Most of the implementation is in the ensuring package.
 // ensure.New should be used to create an instance of the ensure framework,
@@ -0,0 +1,73 @@
+package ensuring
+
+import (
+	"fmt"
+	"strings"
+)
+
+type contextEntry struct {
+	key   string
+	value interface{}
+}
+
+// Because attaches a reason to the assertion chained after it, which is included in the failure message.
+// The formatted reason follows the same format as the fmt package.
+//
+// For example:
+//
+//	ensure(user.IsActive).Because("user %d was just activated", user.ID).IsTrue()
+func (c *Chain) Because(format string, args ...interface{}) *Chain {
+	c.reasons = append(c.reasons, fmt.Sprintf(format, args...))
+	return c
+}
+
+// WithContext returns an instance of ensure that includes the key and value in the failure messages of all
+// assertions. Calls can be chained to include multiple keys and values, which are listed in the order they were
+// added. The context is included once in the combined failure message of [E.Soft], [E.Eventually], and
+// [E.Consistently], but it is not shared with [E.Run] or the other methods that create a new test.
+//
+// For example:
+//
+//	for i, user := range users {
+//		ensure := ensure.WithContext("index", i).WithContext("userID", user.ID)
+//		ensure(user.IsActive).IsTrue()
+//	}
+func (e E) WithContext(key string, value interface{}) E {
+	return func(actual interface{}) *Chain {
+		c := e(actual)
+		c.context = append(c.context, contextEntry{key: key, value: value})
+		return c
+	}
+}
+
+// annotate appends the reasons and context to the failure message, if there are any.
+func (c *Chain) annotate(format string, args []interface{}) (string, []interface{}) {
+	if len(c.reasons) == 0 && len(c.context) == 0 {
+		return format, args
+	}
+
+	sections := []string{}
+	if len(c.reasons) > 0 {
+		lines := make([]string, 0, len(c.reasons))
+		for _, reason := range c.reasons {
+			lines = append(lines, indent+reason)
+		}
+
+		sections = append(sections, "BECAUSE:\n"+strings.Join(lines, "\n"))
+	}
+
+	if len(c.context) > 0 {
+		lines := make([]string, 0, len(c.context))
+		for _, entry := range c.context {
+			lines = append(lines, fmt.Sprintf("%s%s: %+v", indent, entry.key, entry.value))
+		}
+
+		sections = append(sections, "CONTEXT:\n"+strings.Join(lines, "\n"))
+	}
+
+	annotatedArgs := make([]interface{}, 0, len(args)+1)
+	annotatedArgs = append(annotatedArgs, args...)
+	annotatedArgs = append(annotatedArgs, strings.Join(sections, "\n\n"))
+
+	return format + "\n\n%s", annotatedArgs
+}
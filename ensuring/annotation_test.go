@@ -0,0 +1,225 @@
+package ensuring_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainBecause(t *testing.T) {
+	t.Run("when assertion succeeds", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(true).Because("user %d is active", 123).IsTrue()
+	})
+
+	t.Run("when assertion fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Got false, expected true\n\n%s",
+			"BECAUSE:\n  user 123 is active",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(false).Because("user %d is active", 123).IsTrue()
+	})
+
+	t.Run("when multiple reasons are provided", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Got false, expected true\n\n%s",
+			"BECAUSE:\n  user is active\n  user was just created",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(false).Because("user is active").Because("user was just created").IsTrue()
+	})
+
+	t.Run("when assertion is invalid", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Got type %T, expected boolean\n\n%s",
+			"abc",
+			"BECAUSE:\n  user is active",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Because("user is active").IsTrue()
+	})
+
+	t.Run("when negated assertion succeeds", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s\n\n%s",
+			"equal",
+			"  int(1)",
+			"  int(1)",
+			"BECAUSE:\n  IDs are unique",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1).Because("IDs are unique").Not().Equals(1)
+	})
+
+	t.Run("when IsError fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		err1 := errors.New("my error")
+		err2 := errors.New("other error")
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s\n\n%s",
+			err1.Error(),
+			err2.Error(),
+			"BECAUSE:\n  the request was rejected",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err1).Because("the request was rejected").IsError(err2)
+	})
+}
+
+func TestEnsureWithContext(t *testing.T) {
+	t.Run("when assertion succeeds", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT).WithContext("userID", 123)
+		ensure(true).IsTrue()
+	})
+
+	t.Run("when assertion fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Got false, expected true\n\n%s",
+			"CONTEXT:\n  index: 2\n  userID: 123",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT).WithContext("index", 2).WithContext("userID", 123)
+		ensure(false).IsTrue()
+	})
+
+	t.Run("when assertion fails with a reason", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Got false, expected true\n\n%s",
+			"BECAUSE:\n  user is active\n\nCONTEXT:\n  userID: 123",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT).WithContext("userID", 123)
+		ensure(false).Because("user is active").IsTrue()
+	})
+
+	t.Run("when MatchesAllErrors fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		err1 := errors.New("my error")
+		err2 := errors.New("other error")
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not all of the expected errors:\n\tActual:\n\t     %s\n\n\tExpected all of:%s\n\n%s",
+			err1.Error(),
+			"\n\t  ✅ my error\n\t  ❌ other error",
+			"CONTEXT:\n  requestID: abc",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT).WithContext("requestID", "abc")
+		ensure(err1).MatchesAllErrors(err1, err2)
+	})
+
+	t.Run("when Failf is called", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("something %s\n\n%s", "failed", "CONTEXT:\n  userID: 123").After(
+			mockT.EXPECT().Helper(),
+		)
+
+		ensure := ensure.New(mockT).WithContext("userID", 123)
+		ensure.Failf("something %s", "failed")
+	})
+
+	t.Run("when Soft fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		mockT.EXPECT().Fatalf(
+			"\nSoft assertions failed:\n\n%s\n\n%s",
+			"FAILURE 1 of 1:\n  Got false, expected true",
+			"CONTEXT:\n  userID: 123",
+		)
+
+		ensure := ensure.New(mockT).WithContext("userID", 123)
+		ensure.Soft(func(ensure ensuring.E) {
+			ensure(false).IsTrue()
+		})
+	})
+
+	t.Run("when Eventually times out", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		mockT.EXPECT().Fatalf(
+			"\nEventually timed out after %s. Attempt %d failed with:\n\n%s\n\n%s",
+			time.Duration(0),
+			1,
+			"  Got false, expected true",
+			"CONTEXT:\n  userID: 123",
+		)
+
+		ensure := ensure.New(mockT).WithContext("userID", 123)
+		ensure.Eventually(0, time.Millisecond, func(ensure ensuring.E) {
+			ensure(false).IsTrue()
+		})
+	})
+
+	t.Run("when Consistently fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		mockT.EXPECT().Fatalf(
+			"\nConsistently failed after %s. Attempt %d failed with:\n\n%s\n\n%s",
+			gomock.Any(),
+			1,
+			"  Got false, expected true",
+			"CONTEXT:\n  userID: 123",
+		)
+
+		ensure := ensure.New(mockT).WithContext("userID", 123)
+		ensure.Consistently(time.Minute, time.Millisecond, func(ensure ensuring.E) {
+			ensure(false).IsTrue()
+		})
+	})
+
+	t.Run("when context is not shared with the parent", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got false, expected true").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		_ = ensure.WithContext("userID", 123)
+		ensure(false).IsTrue()
+	})
+}
@@ -6,10 +6,9 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"sync"
 	"unicode/utf8"
 
-	"github.com/go-test/deep"
+	"github.com/JosiahWitt/ensure/internal/diff"
 	"github.com/kr/pretty"
 	"github.com/kr/text"
 )
@@ -21,24 +20,20 @@ const (
 	typeByteSlice = "[]byte"
 )
 
-// Mutex to synchronize accessing deep.
-//
-//nolint:gochecknoglobals // Deep global variables need a global mutex.
-var deepGlobalMu sync.Mutex
-
 // IsTrue ensures the actual value is the boolean "true".
 func (c *Chain) IsTrue() {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be true")()
 
 	actual, ok := c.actual.(bool)
 	if !ok {
-		c.t.Fatalf("Got type %T, expected boolean", c.actual)
+		c.invalid("Got type %T, expected boolean", c.actual)
 		return
 	}
 
 	if !actual {
-		c.t.Fatalf("Got false, expected true")
+		c.fail("Got false, expected true")
 	}
 }
 
@@ -46,15 +41,16 @@ func (c *Chain) IsTrue() {
 func (c *Chain) IsFalse() {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be false")()
 
 	actual, ok := c.actual.(bool)
 	if !ok {
-		c.t.Fatalf("Got type %T, expected boolean", c.actual)
+		c.invalid("Got type %T, expected boolean", c.actual)
 		return
 	}
 
 	if actual {
-		c.t.Fatalf("Got true, expected false")
+		c.fail("Got true, expected false")
 	}
 }
 
@@ -62,9 +58,10 @@ func (c *Chain) IsFalse() {
 func (c *Chain) IsNil() {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be nil")()
 
 	if !isNil(c.actual) {
-		c.t.Fatalf("Got %+v, expected nil", c.actual)
+		c.fail("Got %+v, expected nil", c.actual)
 	}
 }
 
@@ -72,67 +69,97 @@ func (c *Chain) IsNil() {
 func (c *Chain) IsNotNil() {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be non-nil")()
 
 	if isNil(c.actual) {
-		c.t.Fatalf("Got nil of type %T, expected it not to be nil", c.actual)
+		c.fail("Got nil of type %T, expected it not to be nil", c.actual)
 	}
 }
 
 // Equals ensures the actual value equals the expected value.
-// Equals uses deep.Equal to print easy to read diffs.
-func (c *Chain) Equals(expected interface{}) {
+// Equals prints easy to read diffs when the values are not equal.
+// When strings or UTF-8 []byte values span multiple lines, a unified line diff is printed.
+//
+// Options can be provided to customize the comparison. They only apply to this call.
+// For example:
+//
+//	ensure(actual).Equals(expected, ensuring.IgnoreFields("UpdatedAt"), ensuring.NilEqualsEmpty())
+func (c *Chain) Equals(expected interface{}, opts ...EqualsOption) {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("equal", expected)()
 
 	// If we expect nil, return early if actual is nil or if it is a nil pointer
 	if expected == nil && isNil(c.actual) {
 		return
 	}
 
-	results := checkEquality(c.actual, expected)
+	actual, expected, err := collectIterators(c.actual, expected)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	results := checkEquality(actual, expected, opts)
 	if len(results) > 0 {
-		format, args := formatInequalityMessage(results, c.actual, expected)
-		c.t.Fatalf(format, args...)
+		format, args := formatInequalityMessage(results, actual, expected)
+		c.fail(format, args...)
 	}
 }
 
 // IsEmpty ensures that the actual value is empty.
-// It only supports arrays, slices, strings, or maps.
+// It only supports arrays, slices, strings, maps, or iterators.
 func (c *Chain) IsEmpty() {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be empty")()
 
-	length, err := lengthOf(c.actual)
+	actual, err := collectIterator(c.actual)
 	if err != nil {
-		c.t.Fatalf(err.Error())
+		c.invalid(err.Error())
+		return
+	}
+
+	length, err := lengthOf(actual)
+	if err != nil {
+		c.invalid(err.Error())
 		return
 	}
 
 	if length > 0 {
-		c.t.Fatalf("Got %+v with length %d, expected it to be empty", c.actual, length)
+		c.fail("Got %+v with length %d, expected it to be empty", actual, length)
 	}
 }
 
 // IsNotEmpty ensures that the actual value is not empty.
-// It only supports arrays, slices, strings, or maps.
+// It only supports arrays, slices, strings, maps, or iterators.
 func (c *Chain) IsNotEmpty() {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be non-empty")()
+
+	actual, err := collectIterator(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
 
-	length, err := lengthOf(c.actual)
+	length, err := lengthOf(actual)
 	if err != nil {
-		c.t.Fatalf(err.Error())
+		c.invalid(err.Error())
 		return
 	}
 
 	if length == 0 {
-		c.t.Fatalf("Got %+v, expected it to not be empty", c.actual)
+		c.fail("Got %+v, expected it to not be empty", actual)
 	}
 }
 
 // Contains ensures that the actual value contains the expected value.
-// It only supports searching strings, arrays, or slices for the expected value.
+// It only supports searching strings, arrays, slices, or iterators for the expected value.
 // If both the actual and expected are strings, strings.Contains(...) is used.
+// Otherwise, elements are compared the same way as [Chain.Equals].
+// Pairs yielded by an [iter.Seq2] are compared as a [KeyValue].
 //
 // For example:
 //
@@ -144,24 +171,41 @@ func (c *Chain) IsNotEmpty() {
 func (c *Chain) Contains(expected interface{}) {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("contain", expected)()
 
-	doesContain, err := contains(c.actual, expected)
+	actual, err := collectIterator(c.actual)
 	if err != nil {
-		c.t.Fatalf(err.Error())
+		c.invalid(err.Error())
+		return
+	}
+
+	doesContain, err := contains(actual, expected)
+	if err != nil {
+		c.invalid(err.Error())
 		return
 	}
 
 	if !doesContain {
-		c.t.Fatalf(
-			"Actual does not contain expected:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN:\n%s",
-			prettyFormat(c.actual),
-			prettyFormat(expected),
-		)
+		const format = "Actual does not contain expected:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN:\n%s"
+
+		if index, differences, ok := closestElementDifferences(actual, expected); ok {
+			c.fail(
+				format+"\n\nCLOSEST ELEMENT [%d] DIFFERS FROM EXPECTED:%s",
+				prettyFormat(actual),
+				prettyFormat(expected),
+				index,
+				formatDifferences(differences),
+			)
+
+			return
+		}
+
+		c.fail(format, prettyFormat(actual), prettyFormat(expected))
 	}
 }
 
 // DoesNotContain ensures that the actual value does not contain the expected value.
-// It only supports verifying that strings, arrays, or slices do not contain the expected value.
+// It only supports verifying that strings, arrays, slices, or iterators do not contain the expected value.
 // If both the actual and expected are strings, strings.Contains(...) is used.
 //
 // For example:
@@ -174,17 +218,24 @@ func (c *Chain) Contains(expected interface{}) {
 func (c *Chain) DoesNotContain(expected interface{}) {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("not contain", expected)()
+
+	actual, err := collectIterator(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
 
-	doesContain, err := contains(c.actual, expected)
+	doesContain, err := contains(actual, expected)
 	if err != nil {
-		c.t.Fatalf(err.Error())
+		c.invalid(err.Error())
 		return
 	}
 
 	if doesContain {
-		c.t.Fatalf(
+		c.fail(
 			"Actual contains expected, but did not expect it to:\n\nACTUAL:\n%s\n\nEXPECTED NOT TO CONTAIN:\n%s",
-			prettyFormat(c.actual),
+			prettyFormat(actual),
 			prettyFormat(expected),
 		)
 	}
@@ -195,27 +246,28 @@ func (c *Chain) DoesNotContain(expected interface{}) {
 func (c *Chain) MatchesRegexp(pattern string) {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("match regular expression", pattern)()
 
 	if pattern == "" {
-		c.t.Fatalf("Cannot match against an empty pattern")
+		c.invalid("Cannot match against an empty pattern")
 		return
 	}
 
 	actualStr, ok := c.actual.(string)
 	if !ok {
-		c.t.Fatalf("Actual is not a string, it's a %T", c.actual)
+		c.invalid("Actual is not a string, it's a %T", c.actual)
 		return
 	}
 
 	patternRegexp, err := regexp.Compile(pattern)
 	if err != nil {
-		c.t.Fatalf("Unable to compile regular expression: %s\nERROR: %v", pattern, err)
+		c.invalid("Unable to compile regular expression: %s\nERROR: %v", pattern, err)
 		return
 	}
 
 	isMatch := patternRegexp.MatchString(actualStr)
 	if !isMatch {
-		c.t.Fatalf(
+		c.fail(
 			"Actual does not match regular expression:\n\nACTUAL:\n%s\n\nEXPECTED TO MATCH:\n%s",
 			prettyFormat(c.actual),
 			prettyFormat(pattern),
@@ -272,7 +324,7 @@ func contains(items, value interface{}) (bool, error) {
 
 	for i := range itemsReflectValue.Len() {
 		item := itemsReflectValue.Index(i)
-		if reflect.DeepEqual(item.Interface(), value) {
+		if len(checkEquality(item.Interface(), value, nil)) == 0 {
 			return true, nil
 		}
 	}
@@ -280,17 +332,84 @@ func contains(items, value interface{}) (bool, error) {
 	return false, nil
 }
 
-func formatInequalityMessage(diff []string, actual, expected interface{}) (string, []interface{}) {
+// closestElementDifferences finds the element in items with the fewest differences from value.
+// It only considers elements of the same type as value, when value is a composite type, since diffs of
+// individual strings or numbers are not more helpful than the values themselves.
+func closestElementDifferences(items, value interface{}) (int, []diff.Difference, bool) {
+	itemsReflectValue := reflect.ValueOf(items)
+	itemsReflectKind := itemsReflectValue.Kind()
+	if value == nil || (itemsReflectKind != reflect.Array && itemsReflectKind != reflect.Slice) {
+		return 0, nil, false
+	}
+
+	valueType := reflect.TypeOf(value)
+	if !isCompositeType(valueType) {
+		return 0, nil, false
+	}
+
+	closestIndex := -1
+	var closestDifferences []diff.Difference
+	for i := range itemsReflectValue.Len() {
+		item := itemsReflectValue.Index(i)
+		if item.Kind() == reflect.Interface {
+			item = item.Elem()
+		}
+
+		if !item.IsValid() || item.Type() != valueType {
+			continue
+		}
+
+		differences := checkEquality(item.Interface(), value, nil)
+		if closestIndex == -1 || len(differences) < len(closestDifferences) {
+			closestIndex = i
+			closestDifferences = differences
+		}
+	}
+
+	return closestIndex, closestDifferences, closestIndex != -1
+}
+
+func isCompositeType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	//nolint:exhaustive // Only composite kinds are relevant
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	default:
+		return false
+	}
+}
+
+func formatInequalityMessage(differences []diff.Difference, actual, expected interface{}) (string, []interface{}) {
 	const actualVsExpected = "ACTUAL:\n%s\n\nEXPECTED:\n%s"
 
+	p := newPalette()
+
 	actualStr, actualType, actualIsStr := isStringLike(actual)
 	expectedStr, expectedType, expectedIsStr := isStringLike(expected)
 	if actualIsStr && expectedIsStr {
+		isMultiline := strings.Contains(actualStr, "\n") || strings.Contains(expectedStr, "\n")
+		if actualType == expectedType && isMultiline {
+			return "\nActual %s does not equal expected %s:\n\nDIFF (-actual +expected):\n%s", []interface{}{
+				actualType,
+				expectedType,
+				text.Indent(p.colorLineDiff(diff.Lines(actualStr, expectedStr)), indent),
+			}
+		}
+
+		actualOutput, expectedOutput := p.highlightChanges(
+			prettyFormatString(actualStr, actualType),
+			prettyFormatString(expectedStr, expectedType),
+		)
+
 		args := []interface{}{
 			actualType,
 			expectedType,
-			indent + prettyFormatString(actualStr, actualType),
-			indent + prettyFormatString(expectedStr, expectedType),
+			indent + actualOutput,
+			indent + expectedOutput,
 		}
 
 		if actualType != expectedType {
@@ -300,15 +419,10 @@ func formatInequalityMessage(diff []string, actual, expected interface{}) (strin
 		return "\nActual %s does not equal expected %s:\n\n" + actualVsExpected, args
 	}
 
-	errors := "Actual does not equal expected:"
-	for _, result := range diff {
-		errors += "\n - " + result
-	}
-
 	return "\n%s\n\n" + actualVsExpected, []interface{}{
-		errors,
-		prettyFormat(actual),
-		prettyFormat(expected),
+		"Actual does not equal expected:" + formatDifferences(differences),
+		p.dim(prettyFormat(actual)),
+		p.dim(prettyFormat(expected)),
 	}
 }
 
@@ -337,18 +451,8 @@ func prettyFormatString(str string, valType string) string {
 	return quotedString
 }
 
-func checkEquality(actual, expected interface{}) []string {
-	// Since deep only supports global settings, we wrap setting them
-	// and the equality check in a mutex for concurrency safety.
-
-	deepGlobalMu.Lock()
-	defer deepGlobalMu.Unlock()
-
-	deep.CompareUnexportedFields = true
-	deep.NilMapsAreEmpty = false
-	deep.NilSlicesAreEmpty = false
-
-	return deep.Equal(actual, expected)
+func checkEquality(actual, expected interface{}, opts []EqualsOption) []diff.Difference {
+	return diff.Compare(actual, expected, buildDiffOptions(opts))
 }
 
 func isStringLike(value interface{}) (string, string, bool) {
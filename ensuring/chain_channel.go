@@ -0,0 +1,233 @@
+package ensuring
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ReceivesWithin ensures the actual value is a channel that receives a value within the provided duration.
+// The received value is returned, so further assertions can be made on it.
+// When used within [E.RunSync] or [E.RunTableByIndexSync], the duration is measured using the [synctest] fake clock.
+//
+// For example:
+//
+//	value := ensure(results).ReceivesWithin(time.Second).(Result)
+//	ensure(value.Status).Equals("done")
+func (c *Chain) ReceivesWithin(d time.Duration) interface{} {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("receive a value within " + d.String())()
+
+	ch, err := receivableChannelOf(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return nil
+	}
+
+	value, result := receiveWithin(ch, time.After(d))
+	switch result {
+	case receiveBlocked:
+		c.fail("Actual channel did not receive a value within %s", d)
+		return nil
+	case receiveClosed:
+		c.fail("Actual channel was closed before a value was received")
+		return nil
+	}
+
+	return value.Interface()
+}
+
+// ReceivesEqual ensures the actual value is a channel that receives a value within the provided duration,
+// and that the received value equals the expected value. Values are compared the same way as [Chain.Equals].
+// When used within [E.RunSync] or [E.RunTableByIndexSync], the duration is measured using the [synctest] fake clock.
+//
+// For example:
+//
+//	ensure(results).ReceivesEqual("done", time.Second)
+func (c *Chain) ReceivesEqual(expected interface{}, d time.Duration) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("receive within "+d.String(), expected)()
+
+	ch, err := receivableChannelOf(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	value, result := receiveWithin(ch, time.After(d))
+	switch result {
+	case receiveBlocked:
+		c.fail("\nActual channel did not receive a value within %s\n\nEXPECTED:\n%s", d, prettyFormat(expected))
+		return
+	case receiveClosed:
+		c.fail("\nActual channel was closed before a value was received\n\nEXPECTED:\n%s", prettyFormat(expected))
+		return
+	}
+
+	received := value.Interface()
+	if differences := checkEquality(received, expected, nil); len(differences) > 0 {
+		c.fail(
+			"\nActual channel received a value that does not equal expected:%s\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			prettyFormat(received),
+			prettyFormat(expected),
+		)
+	}
+}
+
+// IsClosed ensures the actual value is a channel that is closed, without waiting.
+// Any value that is still buffered in the channel is received, and causes the assertion to fail.
+//
+// For example:
+//
+//	close(done)
+//	ensure(done).IsClosed()
+func (c *Chain) IsClosed() {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be closed")()
+
+	ch, err := receivableChannelOf(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	value, result := receiveWithin(ch, nil)
+	switch result {
+	case receiveBlocked:
+		c.fail("Actual channel is not closed, and no value was received")
+	case receiveOK:
+		c.fail("\nActual channel is not closed, and received:\n%s", prettyFormat(value.Interface()))
+	}
+}
+
+// BlocksFor ensures the actual value is a channel that does not receive a value and is not closed
+// for the provided duration.
+// When used within [E.RunSync] or [E.RunTableByIndexSync], the duration is measured using the [synctest] fake clock.
+//
+// For example:
+//
+//	ensure(results).BlocksFor(100 * time.Millisecond)
+func (c *Chain) BlocksFor(d time.Duration) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("block for " + d.String())()
+
+	ch, err := receivableChannelOf(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	value, result := receiveWithin(ch, time.After(d))
+	switch result {
+	case receiveClosed:
+		c.fail("Actual channel was closed within %s, but was expected to block", d)
+	case receiveOK:
+		c.fail("\nActual channel received a value within %s, but was expected to block:\n%s", d, prettyFormat(value.Interface()))
+	}
+}
+
+// EmitsSequence ensures the actual value is a channel that receives the values in the expected slice, in order,
+// within the provided duration. Values received after the expected sequence are not checked.
+// Values are compared the same way as [Chain.Equals].
+// When used within [E.RunSync] or [E.RunTableByIndexSync], the duration is measured using the [synctest] fake clock.
+//
+// For example:
+//
+//	ensure(results).EmitsSequence([]string{"started", "done"}, time.Second)
+func (c *Chain) EmitsSequence(expected interface{}, d time.Duration) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("emit sequence within "+d.String(), expected)()
+
+	ch, err := receivableChannelOf(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	expectedValue := reflect.ValueOf(expected)
+	if expectedValue.Kind() != reflect.Slice && expectedValue.Kind() != reflect.Array {
+		c.invalid("Expected has type %T, expected array or slice", expected)
+		return
+	}
+
+	received := reflect.MakeSlice(reflect.SliceOf(ch.Type().Elem()), 0, expectedValue.Len())
+	timeout := time.After(d)
+
+	for received.Len() < expectedValue.Len() {
+		value, result := receiveWithin(ch, timeout)
+		if result == receiveBlocked {
+			c.fail(
+				"\nActual channel did not emit the expected sequence within %s\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+				d,
+				prettyFormat(received.Interface()),
+				prettyFormat(expected),
+			)
+			return
+		}
+
+		if result == receiveClosed {
+			c.fail(
+				"\nActual channel was closed before emitting the expected sequence\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+				prettyFormat(received.Interface()),
+				prettyFormat(expected),
+			)
+			return
+		}
+
+		received = reflect.Append(received, value)
+	}
+
+	if differences := checkEquality(received.Interface(), expected, nil); len(differences) > 0 {
+		c.fail(
+			"\nActual channel did not emit the expected sequence:%s\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			prettyFormat(received.Interface()),
+			prettyFormat(expected),
+		)
+	}
+}
+
+type receiveResult int
+
+const (
+	receiveOK receiveResult = iota
+	receiveClosed
+	receiveBlocked
+)
+
+// receiveWithin receives from ch until a value is received, ch is closed, or timeout fires (reported as blocked).
+// If timeout is nil, it does not wait.
+func receiveWithin(ch reflect.Value, timeout <-chan time.Time) (reflect.Value, receiveResult) {
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: ch}}
+	if timeout == nil {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	} else {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timeout)})
+	}
+
+	chosen, value, ok := reflect.Select(cases)
+	switch {
+	case chosen != 0:
+		return reflect.Value{}, receiveBlocked
+	case !ok:
+		return reflect.Value{}, receiveClosed
+	default:
+		return value, receiveOK
+	}
+}
+
+func receivableChannelOf(value interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Chan || v.Type().ChanDir()&reflect.RecvDir == 0 {
+		//lint:ignore ST1005 Only used internally
+		return reflect.Value{}, fmt.Errorf("Got type %T, expected a channel that can receive", value) //nolint:err113 // Only used internally
+	}
+
+	return v, nil
+}
@@ -0,0 +1,363 @@
+package ensuring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosiahWitt/ensure"
+)
+
+func TestChainReceivesWithin(t *testing.T) {
+	t.Run("when value is received", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ch := make(chan string, 1)
+		ch <- "abc"
+
+		ensure := ensure.New(mockT)
+		value := ensure(ch).ReceivesWithin(time.Second)
+
+		if value != "abc" {
+			t.Errorf("expected the received value to be returned, got: %v", value)
+		}
+	})
+
+	t.Run("when value is received from a receive-only channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ch := make(chan int, 1)
+		ch <- 123
+
+		ensure := ensure.New(mockT)
+		value := ensure((<-chan int)(ch)).ReceivesWithin(time.Second)
+
+		if value != 123 {
+			t.Errorf("expected the received value to be returned, got: %v", value)
+		}
+	})
+
+	t.Run("when nothing is received", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual channel did not receive a value within %s", time.Millisecond).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		value := ensure(make(chan string)).ReceivesWithin(time.Millisecond)
+
+		if value != nil {
+			t.Errorf("expected nil to be returned, got: %v", value)
+		}
+	})
+
+	t.Run("when channel is closed", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual channel was closed before a value was received").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan string)
+		close(ch)
+
+		ensure := ensure.New(mockT)
+		ensure(ch).ReceivesWithin(time.Second)
+	})
+
+	t.Run("when actual is not a channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type string, expected a channel that can receive").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").ReceivesWithin(time.Second)
+	})
+
+	t.Run("when actual is a send-only channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type chan<- string, expected a channel that can receive").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan<- string)).ReceivesWithin(time.Second)
+	})
+}
+
+func TestChainReceivesEqual(t *testing.T) {
+	t.Run("when received value is equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ch := make(chan ExamplePerson, 1)
+		ch <- ExamplePerson{Name: "John"}
+
+		ensure := ensure.New(mockT)
+		ensure(ch).ReceivesEqual(ExamplePerson{Name: "John"}, time.Second)
+	})
+
+	t.Run("when received value is not equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel received a value that does not equal expected:%s\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+			"\n - Name: \"John\" != \"Sam\"",
+			ExamplePerson{Name: "John"}.ExpectedOutput(),
+			ExamplePerson{Name: "Sam"}.ExpectedOutput(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan ExamplePerson, 1)
+		ch <- ExamplePerson{Name: "John"}
+
+		ensure := ensure.New(mockT)
+		ensure(ch).ReceivesEqual(ExamplePerson{Name: "Sam"}, time.Second)
+	})
+
+	t.Run("when nothing is received", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel did not receive a value within %s\n\nEXPECTED:\n%s",
+			time.Millisecond,
+			`  "abc"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan string)).ReceivesEqual("abc", time.Millisecond)
+	})
+
+	t.Run("when channel is closed", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel was closed before a value was received\n\nEXPECTED:\n%s",
+			`  "abc"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan string)
+		close(ch)
+
+		ensure := ensure.New(mockT)
+		ensure(ch).ReceivesEqual("abc", time.Second)
+	})
+
+	t.Run("when actual is not a channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type int, expected a channel that can receive").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(123).ReceivesEqual(123, time.Second)
+	})
+}
+
+func TestChainIsClosed(t *testing.T) {
+	t.Run("when channel is closed", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ch := make(chan struct{})
+		close(ch)
+
+		ensure := ensure.New(mockT)
+		ensure(ch).IsClosed()
+	})
+
+	t.Run("when channel is open and empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual channel is not closed, and no value was received").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan struct{})).IsClosed()
+	})
+
+	t.Run("when channel has a buffered value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual channel is not closed, and received:\n%s", `  "abc"`).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan string, 1)
+		ch <- "abc"
+		close(ch)
+
+		ensure := ensure.New(mockT)
+		ensure(ch).IsClosed()
+	})
+
+	t.Run("when actual is not a channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type <nil>, expected a channel that can receive").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(nil).IsClosed()
+	})
+
+	t.Run("when negated and channel is open", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan struct{})).Not().IsClosed()
+	})
+}
+
+func TestChainBlocksFor(t *testing.T) {
+	t.Run("when channel blocks", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan string)).BlocksFor(time.Millisecond)
+	})
+
+	t.Run("when value is received", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel received a value within %s, but was expected to block:\n%s",
+			time.Second,
+			`  "abc"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan string, 1)
+		ch <- "abc"
+
+		ensure := ensure.New(mockT)
+		ensure(ch).BlocksFor(time.Second)
+	})
+
+	t.Run("when channel is closed", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual channel was closed within %s, but was expected to block", time.Second).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan string)
+		close(ch)
+
+		ensure := ensure.New(mockT)
+		ensure(ch).BlocksFor(time.Second)
+	})
+
+	t.Run("when actual is not a channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type []string, expected a channel that can receive").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]string{}).BlocksFor(time.Second)
+	})
+}
+
+func TestChainEmitsSequence(t *testing.T) {
+	t.Run("when sequence is emitted", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ch := make(chan int)
+		go func() {
+			for i := 1; i <= 3; i++ {
+				ch <- i
+			}
+		}()
+
+		ensure := ensure.New(mockT)
+		ensure(ch).EmitsSequence([]int{1, 2, 3}, time.Second)
+	})
+
+	t.Run("when sequence is empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan int)).EmitsSequence([]int{}, time.Second)
+	})
+
+	t.Run("when sequence is different", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel did not emit the expected sequence:%s\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+			"\n - [1]: 3 != 2",
+			"  []int{1, 3}",
+			"  []int{1, 2}",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 3
+
+		ensure := ensure.New(mockT)
+		ensure(ch).EmitsSequence([]int{1, 2}, time.Second)
+	})
+
+	t.Run("when sequence is not emitted in time", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel did not emit the expected sequence within %s\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+			time.Millisecond,
+			"  []int{1}",
+			"  []int{1, 2}",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan int, 1)
+		ch <- 1
+
+		ensure := ensure.New(mockT)
+		ensure(ch).EmitsSequence([]int{1, 2}, time.Millisecond)
+	})
+
+	t.Run("when channel is closed early", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual channel was closed before emitting the expected sequence\n\nRECEIVED:\n%s\n\nEXPECTED:\n%s",
+			"  []int{1}",
+			"  []int{1, 2}",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ch := make(chan int, 1)
+		ch <- 1
+		close(ch)
+
+		ensure := ensure.New(mockT)
+		ensure(ch).EmitsSequence([]int{1, 2}, time.Second)
+	})
+
+	t.Run("when expected is not a slice", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Expected has type %T, expected array or slice", 1).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(make(chan int)).EmitsSequence(1, time.Second)
+	})
+
+	t.Run("when actual is not a channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type []int, expected a channel that can receive").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]int{1}).EmitsSequence([]int{1}, time.Second)
+	})
+}
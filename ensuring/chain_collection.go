@@ -0,0 +1,212 @@
+package ensuring
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ElementsMatch ensures the actual and expected arrays or slices contain the same elements, ignoring their order.
+// Each element must occur the same number of times in both. Elements are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure([]int{1, 2, 3}).ElementsMatch([]int{3, 1, 2}) // Succeeds
+//	ensure([]int{1, 2, 2}).ElementsMatch([]int{1, 2}) // Fails
+func (c *Chain) ElementsMatch(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("match elements", expected)()
+
+	actualElements, err := elementsOf(c.actual, "Actual")
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	expectedElements, err := elementsOf(expected, "Expected")
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	var missing, extra, duplicated []string
+	for _, group := range groupElements(actualElements, expectedElements) {
+		switch {
+		case group.actualCount == group.expectedCount:
+			continue
+		case group.actualCount == 0:
+			missing = append(missing, formatElementWithCount(group.value, group.expectedCount))
+		case group.expectedCount == 0:
+			extra = append(extra, formatElementWithCount(group.value, group.actualCount))
+		default:
+			duplicated = append(duplicated, prettyFormat(group.value)+
+				fmt.Sprintf(" (actual: %d, expected: %d)", group.actualCount, group.expectedCount))
+		}
+	}
+
+	if len(missing) == 0 && len(extra) == 0 && len(duplicated) == 0 {
+		return
+	}
+
+	c.fail(
+		"\nActual elements do not match expected elements:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+		formatElementSections(
+			elementSection{"MISSING FROM ACTUAL", missing},
+			elementSection{"EXTRA IN ACTUAL", extra},
+			elementSection{"DUPLICATED", duplicated},
+		),
+		prettyFormat(c.actual),
+		prettyFormat(expected),
+	)
+}
+
+// ContainsAll ensures that the actual value contains each of the expected items.
+// It supports the same values as [Chain.Contains].
+//
+// For example:
+//
+//	ensure([]string{"abc", "xyz"}).ContainsAll("xyz", "abc") // Succeeds
+//	ensure([]string{"abc", "xyz"}).ContainsAll("xyz", "qwerty") // Fails
+func (c *Chain) ContainsAll(items ...interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("contain all of", items)()
+
+	missing := []string{}
+	for _, item := range items {
+		doesContain, err := contains(c.actual, item)
+		if err != nil {
+			c.invalid(err.Error())
+			return
+		}
+
+		if !doesContain {
+			missing = append(missing, prettyFormat(item))
+		}
+	}
+
+	if len(missing) > 0 {
+		c.fail(
+			"\nActual does not contain all expected items:%s\n\nACTUAL:\n%s",
+			formatElementSections(elementSection{"MISSING FROM ACTUAL", missing}),
+			prettyFormat(c.actual),
+		)
+	}
+}
+
+// ContainsAny ensures that the actual value contains at least one of the expected items.
+// It supports the same values as [Chain.Contains].
+//
+// For example:
+//
+//	ensure([]string{"abc", "xyz"}).ContainsAny("qwerty", "abc") // Succeeds
+//	ensure([]string{"abc", "xyz"}).ContainsAny("qwerty", "asdf") // Fails
+func (c *Chain) ContainsAny(items ...interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("contain any of", items)()
+
+	if len(items) == 0 {
+		c.invalid("Expected at least one item to be provided to ContainsAny")
+		return
+	}
+
+	formattedItems := make([]string, 0, len(items))
+	for _, item := range items {
+		doesContain, err := contains(c.actual, item)
+		if err != nil {
+			c.invalid(err.Error())
+			return
+		}
+
+		if doesContain {
+			return
+		}
+
+		formattedItems = append(formattedItems, prettyFormat(item))
+	}
+
+	c.fail(
+		"\nActual does not contain any of the expected items:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN ANY OF:\n%s",
+		prettyFormat(c.actual),
+		strings.Join(formattedItems, "\n"),
+	)
+}
+
+type elementGroup struct {
+	value         interface{}
+	actualCount   int
+	expectedCount int
+}
+
+// groupElements groups equal elements together, and counts how many times each occurs in actual and expected.
+// Groups are returned in the order they first occur in actual, followed by expected.
+func groupElements(actual, expected []interface{}) []*elementGroup {
+	groups := []*elementGroup{}
+
+	findGroup := func(value interface{}) *elementGroup {
+		for _, group := range groups {
+			if len(checkEquality(value, group.value, nil)) == 0 {
+				return group
+			}
+		}
+
+		group := &elementGroup{value: value}
+		groups = append(groups, group)
+		return group
+	}
+
+	for _, value := range actual {
+		findGroup(value).actualCount++
+	}
+
+	for _, value := range expected {
+		findGroup(value).expectedCount++
+	}
+
+	return groups
+}
+
+func elementsOf(value interface{}, name string) ([]interface{}, error) {
+	reflectValue := reflect.ValueOf(value)
+	reflectKind := reflectValue.Kind()
+	if reflectKind != reflect.Array && reflectKind != reflect.Slice {
+		//lint:ignore ST1005 Only used internally
+		return nil, fmt.Errorf("%s has type %T, expected array or slice", name, value) //nolint:err113 // Only used internally
+	}
+
+	elements := make([]interface{}, 0, reflectValue.Len())
+	for i := range reflectValue.Len() {
+		elements = append(elements, reflectValue.Index(i).Interface())
+	}
+
+	return elements, nil
+}
+
+type elementSection struct {
+	title    string
+	elements []string
+}
+
+// formatElementSections formats each non-empty section with its title, followed by its elements on their own lines.
+func formatElementSections(sections ...elementSection) string {
+	formatted := ""
+	for _, section := range sections {
+		if len(section.elements) == 0 {
+			continue
+		}
+
+		formatted += "\n\n" + section.title + ":\n" + strings.Join(section.elements, "\n")
+	}
+
+	return formatted
+}
+
+func formatElementWithCount(value interface{}, count int) string {
+	if count == 1 {
+		return prettyFormat(value)
+	}
+
+	return prettyFormat(value) + fmt.Sprintf(" (%d times)", count)
+}
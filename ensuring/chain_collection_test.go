@@ -0,0 +1,190 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+)
+
+func TestChainElementsMatch(t *testing.T) {
+	const errorMessageFormat = "\nActual elements do not match expected elements:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s"
+
+	t.Run("when elements match", func(t *testing.T) {
+		table := []struct {
+			Name     string
+			Actual   interface{}
+			Expected interface{}
+		}{
+			{
+				Name:     "in the same order",
+				Actual:   []int{1, 2, 3},
+				Expected: []int{1, 2, 3},
+			},
+			{
+				Name:     "in a different order",
+				Actual:   []int{1, 2, 2, 3},
+				Expected: []int{2, 3, 1, 2},
+			},
+			{
+				Name:     "with arrays and slices",
+				Actual:   [3]string{"a", "b", "c"},
+				Expected: []string{"c", "a", "b"},
+			},
+			{
+				Name:     "with structs",
+				Actual:   []ExamplePerson{{Name: "John"}, {Name: "Sam"}},
+				Expected: []ExamplePerson{{Name: "Sam"}, {Name: "John"}},
+			},
+			{
+				Name:     "when both are empty",
+				Actual:   []int(nil),
+				Expected: []int{},
+			},
+		}
+
+		for _, entry := range table {
+			t.Run(entry.Name, func(t *testing.T) {
+				mockT := setupMockTWithCleanupCheck(t)
+				mockT.EXPECT().Helper()
+
+				ensure := ensure.New(mockT)
+				ensure(entry.Actual).ElementsMatch(entry.Expected)
+			})
+		}
+	})
+
+	t.Run("when elements are missing, extra, and duplicated", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(errorMessageFormat,
+			"\n\nMISSING FROM ACTUAL:\n"+
+				"  \"d\" (2 times)\n"+
+				"  \"e\""+
+				"\n\nEXTRA IN ACTUAL:\n"+
+				"  \"a\""+
+				"\n\nDUPLICATED:\n"+
+				"  \"b\" (actual: 2, expected: 1)\n"+
+				"  \"c\" (actual: 1, expected: 3)",
+			`  []string{"a", "b", "b", "c"}`,
+			`  []string{"b", "c", "c", "c", "d", "d", "e"}`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"a", "b", "b", "c"}).ElementsMatch([]string{"b", "c", "c", "c", "d", "d", "e"})
+	})
+
+	t.Run("when actual is not an array or slice", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual has type string, expected array or slice").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").ElementsMatch([]string{"abc"})
+	})
+
+	t.Run("when expected is not an array or slice", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Expected has type map[string]int, expected array or slice").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]int{1}).ElementsMatch(map[string]int{"a": 1})
+	})
+}
+
+func TestChainContainsAll(t *testing.T) {
+	t.Run("when all items are contained", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"abc", "xyz", "qwerty"}).ContainsAll("qwerty", "abc")
+	})
+
+	t.Run("when all substrings are contained", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure("hello world").ContainsAll("world", "hello")
+	})
+
+	t.Run("when no items are provided", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"abc"}).ContainsAll()
+	})
+
+	t.Run("when items are missing", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not contain all expected items:%s\n\nACTUAL:\n%s",
+			"\n\nMISSING FROM ACTUAL:\n  int(2)\n  int(4)",
+			"  []int{1, 3}",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]int{1, 3}).ContainsAll(1, 2, 3, 4)
+	})
+
+	t.Run("when not valid type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type int, expected string, array, or slice").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1234).ContainsAll(2)
+	})
+}
+
+func TestChainContainsAny(t *testing.T) {
+	t.Run("when an item is contained", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"abc", "xyz"}).ContainsAny("qwerty", "xyz")
+	})
+
+	t.Run("when no items are contained", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not contain any of the expected items:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN ANY OF:\n%s",
+			`  []string{"abc", "xyz"}`,
+			"  \"qwerty\"\n  \"asdf\"",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"abc", "xyz"}).ContainsAny("qwerty", "asdf")
+	})
+
+	t.Run("when no items are provided", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Expected at least one item to be provided to ContainsAny").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"abc"}).ContainsAny()
+	})
+
+	t.Run("when not valid type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type int, expected string, array, or slice").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1234).ContainsAny(2)
+	})
+}
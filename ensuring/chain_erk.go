@@ -0,0 +1,163 @@
+package ensuring
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/JosiahWitt/erk"
+)
+
+// HasErkKind ensures the first erk error in the actual error's chain has the expected kind.
+//
+// For example:
+//
+//	ensure(err).HasErkKind(store.ErkNotFound{})
+func (c *Chain) HasErkKind(kind erk.Kind) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have erk kind", kindString(kind))()
+
+	actual, ok := c.findErkError()
+	if !ok {
+		return
+	}
+
+	if actualKind := actual.Kind(); reflect.TypeOf(actualKind) != reflect.TypeOf(kind) {
+		c.fail(
+			"\nActual error does not have the expected kind:\n\tActual:   %s\n\tExpected: %s",
+			buildActualErrorOutput(actual),
+			kindString(kind),
+		)
+	}
+}
+
+// HasErkParams ensures the first erk error in the actual error's chain has exactly the expected params.
+// Params are compared the same way as [Chain.Equals]. Wrapped errors are stored in the erk.OriginalErrorParam param.
+//
+// For example:
+//
+//	ensure(err).HasErkParams(erk.Params{"id": 123})
+func (c *Chain) HasErkParams(expected erk.Params) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have erk params", expected)()
+
+	actual, ok := c.findErkError()
+	if !ok {
+		return
+	}
+
+	actualParams := actual.Params()
+	if differences := checkEquality(actualParams, expected, []EqualsOption{NilEqualsEmpty()}); len(differences) > 0 {
+		c.fail(
+			"\nActual error params do not equal expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			prettyFormat(actualParams),
+			prettyFormat(expected),
+		)
+	}
+}
+
+// HasErkParamsSubset ensures the first erk error in the actual error's chain has each of the expected params.
+// Params that are only on the actual error are ignored, and values are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(err).HasErkParamsSubset(erk.Params{"id": 123})
+func (c *Chain) HasErkParamsSubset(expected erk.Params) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have erk params subset", expected)()
+
+	actual, ok := c.findErkError()
+	if !ok {
+		return
+	}
+
+	// Only keep the expected keys in actual, so the remaining params can be compared with expected
+	actualParams := actual.Params()
+	actualSubset := erk.Params{}
+	for key := range expected {
+		if value, ok := actualParams[key]; ok {
+			actualSubset[key] = value
+		}
+	}
+
+	if differences := checkEquality(actualSubset, expected, []EqualsOption{NilEqualsEmpty()}); len(differences) > 0 {
+		c.fail(
+			"\nActual error params do not contain expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			prettyFormat(actualParams),
+			prettyFormat(expected),
+		)
+	}
+}
+
+// HasErkRawMessage ensures the first erk error in the actual error's chain has the expected raw message.
+// The raw message is the message template, before params are rendered into it.
+//
+// For example:
+//
+//	ensure(err).HasErkRawMessage("user {{.id}} not found")
+func (c *Chain) HasErkRawMessage(expected string) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have erk raw message", expected)()
+
+	actual, ok := c.findErkError()
+	if !ok {
+		return
+	}
+
+	if actualMessage := actual.ExportRawMessage(); actualMessage != expected {
+		c.fail(
+			"\nActual error does not have the expected raw message:\n\tActual:   %q\n\tExpected: %q",
+			actualMessage,
+			expected,
+		)
+	}
+}
+
+// findErkError finds the first erk error in the actual error's chain.
+// If it is not found, the assertion fails, and false is returned.
+func (c *Chain) findErkError() (erk.Erkable, bool) {
+	c.t.Helper()
+
+	actual, ok := c.actual.(error)
+	if !ok && !isNil(c.actual) {
+		c.invalid("Got type %T, expected an error", c.actual)
+		return nil, false
+	}
+
+	// Typed nil errors, like a nil *erk.Error, cannot be formatted, since calling Error() may panic
+	if isNil(c.actual) {
+		actual = nil
+	}
+
+	var erkable erk.Erkable
+	if !errors.As(actual, &erkable) {
+		c.fail(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			buildActualErrorOutput(actual),
+			buildErrorTypesOutput(actual, "\n\t  "),
+		)
+
+		return nil, false
+	}
+
+	if isNil(erkable) {
+		c.fail("\nActual error wraps a nil erk error:\n\tError type: %T", erkable)
+		return nil, false
+	}
+
+	return erkable, true
+}
+
+func kindString(kind erk.Kind) string {
+	if kind == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("%q", kind.KindStringFor(kind))
+}
@@ -0,0 +1,247 @@
+package ensuring_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/erk"
+)
+
+type (
+	erkNotFound struct{ erk.DefaultKind }
+	erkInvalid  struct{ erk.DefaultKind }
+)
+
+func TestChainHasErkKind(t *testing.T) {
+	t.Run("when kind matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		err := fmt.Errorf("wrapped: %w", erk.New(erkNotFound{}, "not found"))
+		ensure(err).HasErkKind(erkNotFound{})
+	})
+
+	t.Run("when kind does not match", func(t *testing.T) {
+		err := erk.NewWith(erkNotFound{}, "not found: {{.id}}", erk.Params{"id": 1})
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not have the expected kind:\n\tActual:   %s\n\tExpected: %s",
+			fmt.Sprintf("{KIND: %q, MESSAGE: \"not found: 1\", PARAMS: map[id:1]}", erk.GetKindString(err)),
+			fmt.Sprintf("%q", erk.GetKindString(erk.New(erkInvalid{}, ""))),
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkKind(erkInvalid{})
+	})
+
+	t.Run("when error is not an erk error", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", errors.New("not erk"))
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			"wrapped: not erk",
+			"\n\t  *fmt.wrapError\n\t    *errors.errorString",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkKind(erkNotFound{})
+	})
+
+	t.Run("when error is nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			"<nil>",
+			"\n\t  <nil>",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(nil).HasErkKind(erkNotFound{})
+	})
+
+	t.Run("when error is a typed nil erk error", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			"<nil>",
+			"\n\t  <nil>",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		var err *erk.Error
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkKind(erkNotFound{})
+	})
+
+	t.Run("when error wraps a typed nil erk error", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual error wraps a nil erk error:\n\tError type: %T", (*erk.Error)(nil)).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(&testHiddenWrapError{err: (*erk.Error)(nil)}).HasErkKind(erkNotFound{})
+	})
+
+	t.Run("when actual is not error type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		const val = "not an error"
+		mockT.EXPECT().Fatalf("Got type %T, expected an error", val).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(val).HasErkKind(erkNotFound{})
+	})
+}
+
+func TestChainHasErkParams(t *testing.T) {
+	t.Run("when params match", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(erk.NewWith(erkNotFound{}, "not found", erk.Params{"id": 1, "name": "abc"})).
+			HasErkParams(erk.Params{"id": 1, "name": "abc"})
+	})
+
+	t.Run("when both have no params", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(erk.New(erkNotFound{}, "not found")).HasErkParams(nil)
+	})
+
+	t.Run("when params do not match", func(t *testing.T) {
+		err := erk.NewWith(erkNotFound{}, "not found", erk.Params{"id": 1, "name": "abc"})
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error params do not equal expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"\n - [\"id\"]: 1 != 2\n - [\"name\"]: \"abc\" != <missing key>",
+			"  erk.Params{\n      \"id\":   int(1),\n      \"name\": \"abc\",\n  }",
+			"  erk.Params{\n      \"id\": int(2),\n  }",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkParams(erk.Params{"id": 2})
+	})
+}
+
+func TestChainHasErkParamsSubset(t *testing.T) {
+	t.Run("when params contain subset", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		err := erk.WrapWith(erk.New(erkNotFound{}, "not found"), errors.New("original"), erk.Params{"id": 1})
+		ensure(err).HasErkParamsSubset(erk.Params{"id": 1})
+	})
+
+	t.Run("when params do not contain subset", func(t *testing.T) {
+		err := erk.NewWith(erkNotFound{}, "not found", erk.Params{"id": 1, "name": "abc"})
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error params do not contain expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"\n - [\"id\"]: 1 != 2\n - [\"other\"]: <missing key> != true",
+			"  erk.Params{\n      \"id\":   int(1),\n      \"name\": \"abc\",\n  }",
+			"  erk.Params{\n      \"id\":    int(2),\n      \"other\": bool(true),\n  }",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkParamsSubset(erk.Params{"id": 2, "other": true})
+	})
+
+	t.Run("when error is not an erk error", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			"not erk",
+			"\n\t  *errors.errorString",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("not erk")).HasErkParamsSubset(erk.Params{"id": 1})
+	})
+}
+
+func TestChainHasErkRawMessage(t *testing.T) {
+	t.Run("when raw message matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		err := fmt.Errorf("wrapped: %w", erk.NewWith(erkNotFound{}, "not found: {{.id}}", erk.Params{"id": 1}))
+		ensure(err).HasErkRawMessage("not found: {{.id}}")
+	})
+
+	t.Run("when raw message does not match", func(t *testing.T) {
+		err := erk.NewWith(erkNotFound{}, "not found: {{.id}}", erk.Params{"id": 1})
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not have the expected raw message:\n\tActual:   %q\n\tExpected: %q",
+			"not found: {{.id}}",
+			"not found: 1",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkRawMessage("not found: 1")
+	})
+
+	t.Run("when error is not an erk error", func(t *testing.T) {
+		err := errors.New("not erk")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			"not erk",
+			"\n\t  *errors.errorString",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkRawMessage("not erk")
+	})
+
+	t.Run("when error is a typed nil erk error", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error does not wrap an erk error:\n\tActual: %s\n\n\tError types:%s",
+			"<nil>",
+			"\n\t  <nil>",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		var err *erk.Error
+
+		ensure := ensure.New(mockT)
+		ensure(err).HasErkRawMessage("not found")
+	})
+}
@@ -3,6 +3,9 @@ package ensuring
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/JosiahWitt/erk"
 )
@@ -12,6 +15,7 @@ import (
 func (c *Chain) IsError(expected error) {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("be error", expected)()
 
 	if isNil(c.actual) && isNil(expected) {
 		return
@@ -19,14 +23,16 @@ func (c *Chain) IsError(expected error) {
 
 	actual, ok := c.actual.(error)
 	if !ok && !isNil(c.actual) {
-		c.t.Fatalf("Got type %T, expected error: \"%v\"", c.actual, expected)
+		c.invalid("Got type %T, expected error: \"%v\"", c.actual, expected)
 		return
 	}
 
 	if !errors.Is(actual, expected) {
-		actualOutput := buildActualErrorOutput(actual)
-		expectedOutput := buildExpectedErrorOutput(expected)
-		c.t.Fatalf("\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s", actualOutput, expectedOutput)
+		actualOutput, expectedOutput := newPalette().highlightChanges(
+			buildActualErrorOutput(actual),
+			buildExpectedErrorOutput(expected),
+		)
+		c.fail("\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s", actualOutput, expectedOutput)
 	}
 }
 
@@ -39,16 +45,17 @@ func (c *Chain) IsError(expected error) {
 func (c *Chain) MatchesAllErrors(expectedErrors ...error) {
 	c.t.Helper()
 	c.markRun()
+	defer c.assertion("match all errors", expectedErrors)()
 
 	actual, ok := c.actual.(error)
 	if !ok && !isNil(c.actual) {
-		c.t.Fatalf("Got type %T, expected an error", c.actual)
+		c.invalid("Got type %T, expected an error", c.actual)
 		return
 	}
 
 	if len(expectedErrors) == 0 {
 		if !isNil(c.actual) {
-			c.t.Fatalf("\nExpected no error, but got: %s", buildActualErrorOutput(actual))
+			c.fail("\nExpected no error, but got: %s", buildActualErrorOutput(actual))
 		}
 
 		return
@@ -59,21 +66,23 @@ func (c *Chain) MatchesAllErrors(expectedErrors ...error) {
 		return
 	}
 
+	p := newPalette()
+
 	failed := false
 	failureDetails := ""
 	for _, expected := range expectedErrors {
-		status := "✅"
+		status, paint := "✅", p.added
 		if !errors.Is(actual, expected) {
 			failed = true
-			status = "❌"
+			status, paint = "❌", p.removed
 		}
 
-		failureDetails += fmt.Sprintf("\n\t  %s %s", status, buildExpectedErrorOutput(expected))
+		failureDetails += "\n\t  " + paint(status+" "+buildExpectedErrorOutput(expected))
 	}
 
 	if failed {
-		actualOutput := buildActualErrorOutput(actual)
-		c.t.Fatalf("\nActual error is not all of the expected errors:\n\tActual:\n\t     %s\n\n\tExpected all of:%s",
+		actualOutput := p.removed(buildActualErrorOutput(actual))
+		c.fail("\nActual error is not all of the expected errors:\n\tActual:\n\t     %s\n\n\tExpected all of:%s",
 			actualOutput,
 			failureDetails,
 		)
@@ -84,9 +93,170 @@ func (c *Chain) MatchesAllErrors(expectedErrors ...error) {
 // It is analogous to IsError(nil).
 func (c *Chain) IsNotError() {
 	c.t.Helper()
+	defer c.assertion("be nil")()
 	c.IsError(nil)
 }
 
+// ErrorAs ensures the actual error, or an error it wraps, has the type T, using errors.As.
+// It returns the matched error, so its fields can be checked. If no error matches, the failure
+// lists the type of every error in the chain, including each branch of joined errors.
+//
+// For example:
+//
+//	validationErr := ensuring.ErrorAs[*ValidationError](ensure(err))
+//	ensure(validationErr.Field).Equals("email")
+func ErrorAs[T error](c *Chain) T {
+	c.t.Helper()
+	c.markRun()
+
+	var target T
+	expectedType := reflect.TypeOf(&target).Elem().String()
+	defer c.assertion("have an error of type " + expectedType)()
+
+	actual, ok := c.actual.(error)
+	if !ok && !isNil(c.actual) {
+		c.invalid("Got type %T, expected an error", c.actual)
+		return target
+	}
+
+	if !errors.As(actual, &target) {
+		c.fail(
+			"\nActual error does not have the expected type:\n\tActual:   %s\n\tExpected: %s\n\n\tError types:%s",
+			buildActualErrorOutput(actual),
+			expectedType,
+			buildErrorTypesOutput(actual, "\n\t  "),
+		)
+	}
+
+	return target
+}
+
+// ErrorContains ensures the message of the actual error, or the message of an error it wraps, contains substr.
+//
+// For example:
+//
+//	ensure(fmt.Errorf("cannot open file: %w", err)).ErrorContains("cannot open") // Succeeds
+//	ensure(errors.New("timeout")).ErrorContains("cannot open") // Fails
+func (c *Chain) ErrorContains(substr string) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have an error message containing", substr)()
+
+	actual, ok := c.actual.(error)
+	if !ok && !isNil(c.actual) {
+		c.invalid("Got type %T, expected an error", c.actual)
+		return
+	}
+
+	// Typed nil errors, like a nil *MyError, cannot be formatted, since calling Error() may panic
+	if isNil(c.actual) {
+		actual = nil
+	}
+
+	for _, message := range errorMessages(actual) {
+		if strings.Contains(message, substr) {
+			return
+		}
+	}
+
+	c.fail(
+		"\nActual error message does not contain the expected text:\n\tActual:   %s\n\tExpected: %q",
+		buildActualErrorOutput(actual),
+		substr,
+	)
+}
+
+// ErrorMatchesRegexp ensures the message of the actual error, or the message of an error it wraps,
+// matches the regular expression pattern.
+//
+// For example:
+//
+//	ensure(errors.New("status 404")).ErrorMatchesRegexp(`status 4\d\d`) // Succeeds
+//	ensure(errors.New("status 500")).ErrorMatchesRegexp(`status 4\d\d`) // Fails
+func (c *Chain) ErrorMatchesRegexp(pattern string) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have an error message matching regular expression", pattern)()
+
+	if pattern == "" {
+		c.invalid("Cannot match against an empty pattern")
+		return
+	}
+
+	actual, ok := c.actual.(error)
+	if !ok && !isNil(c.actual) {
+		c.invalid("Got type %T, expected an error", c.actual)
+		return
+	}
+
+	// Typed nil errors, like a nil *MyError, cannot be formatted, since calling Error() may panic
+	if isNil(c.actual) {
+		actual = nil
+	}
+
+	patternRegexp, err := regexp.Compile(pattern)
+	if err != nil {
+		c.invalid("Unable to compile regular expression: %s\nERROR: %v", pattern, err)
+		return
+	}
+
+	for _, message := range errorMessages(actual) {
+		if patternRegexp.MatchString(message) {
+			return
+		}
+	}
+
+	c.fail(
+		"\nActual error message does not match the regular expression:\n\tActual:   %s\n\tExpected: %s",
+		buildActualErrorOutput(actual),
+		pattern,
+	)
+}
+
+// errorMessages returns the message of err, followed by the messages of the errors it wraps.
+func errorMessages(err error) []string {
+	if isNil(err) {
+		return nil
+	}
+
+	messages := []string{err.Error()}
+
+	//nolint:errorlint // Walking each level of the chain
+	switch wrapper := err.(type) {
+	case interface{ Unwrap() error }:
+		messages = append(messages, errorMessages(wrapper.Unwrap())...)
+	case interface{ Unwrap() []error }:
+		for _, wrapped := range wrapper.Unwrap() {
+			messages = append(messages, errorMessages(wrapped)...)
+		}
+	}
+
+	return messages
+}
+
+// buildErrorTypesOutput lists the type of each error in the chain on its own line, indenting the wrapped errors.
+func buildErrorTypesOutput(err error, linePrefix string) string {
+	if err == nil {
+		return linePrefix + "<nil>"
+	}
+
+	output := linePrefix + fmt.Sprintf("%T", err)
+
+	//nolint:errorlint // Walking each level of the chain
+	switch wrapper := err.(type) {
+	case interface{ Unwrap() error }:
+		if wrapped := wrapper.Unwrap(); wrapped != nil {
+			output += buildErrorTypesOutput(wrapped, linePrefix+"  ")
+		}
+	case interface{ Unwrap() []error }:
+		for _, wrapped := range wrapper.Unwrap() {
+			output += buildErrorTypesOutput(wrapped, linePrefix+"  ")
+		}
+	}
+
+	return output
+}
+
 func buildActualErrorOutput(actual error) string {
 	actualErk, isActualErk := actual.(erk.Erkable) //nolint:errorlint // Want to output the top level error
 	if !isActualErk {
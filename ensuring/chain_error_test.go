@@ -9,6 +9,7 @@ import (
 	"github.com/JosiahWitt/ensure/ensuring"
 	"github.com/JosiahWitt/ensure/internal/mocks/mock_testctx"
 	"github.com/JosiahWitt/erk"
+	"go.uber.org/mock/gomock"
 )
 
 func TestChainIsError(t *testing.T) {
@@ -18,7 +19,7 @@ func TestChainIsError(t *testing.T) {
 		err := errors.New("my error")
 		const val = "not an error"
 		mockT.EXPECT().Fatalf("Got type %T, expected error: \"%v\"", val, err).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -36,7 +37,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 
 		const val = "not an error"
 		mockT.EXPECT().Fatalf("Got type %T, expected an error", val).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -48,7 +49,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			mockT := setupMockTWithCleanupCheck(t)
 
 			mockT.EXPECT().Fatalf("\nExpected no error, but got: %s", "hi").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -67,7 +68,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			mockT := setupMockTWithCleanupCheck(t)
 
 			mockT.EXPECT().Fatalf("\nExpected no error, but got: %s", "hi").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			var errs []error // nil error slice
@@ -143,7 +144,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			err3 := errors.New("my error")
 
 			mockT.EXPECT().Fatalf(errorFormat, err1.Error(), "\n\t  ❌ my error\n\t  ❌ my error").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -157,7 +158,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			err2 := errors.New("my error")
 
 			mockT.EXPECT().Fatalf(errorFormat, err1.Error(), "\n\t  ✅ my error\n\t  ❌ my error").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -172,7 +173,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			err3 := testError{Unique: 3, Message: "error message 3"}
 
 			mockT.EXPECT().Fatalf(errorFormat, err1.Error(), "\n\t  ❌ error message 2\n\t  ❌ error message 3").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -186,7 +187,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			err2 := testError{Unique: 2, Message: "error message 2"}
 
 			mockT.EXPECT().Fatalf(errorFormat, err1.Error(), "\n\t  ❌ error message 2\n\t  ✅ error message 1").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -199,7 +200,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 			err1 := errors.New("my error 1")
 			err2 := errors.New("my error 2")
 			mockT.EXPECT().Fatalf(errorFormat, "<nil>", "\n\t  ❌ my error 1\n\t  ❌ my error 2").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -211,7 +212,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 
 			err := errors.New("my error")
 			mockT.EXPECT().Fatalf(errorFormat, "<nil>", "\n\t  ❌ my error\n\t  ✅ <nil>").After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -237,7 +238,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 					fmt.Sprintf("{KIND: \"%s\", RAW MESSAGE: \"expected 2 {{.a}}\", PARAMS: map[]}", erk.GetKindString(expectedError2)),
 				),
 			).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -262,7 +263,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 					fmt.Sprintf("{KIND: \"%s\", RAW MESSAGE: \"expected {{.a}}\", PARAMS: map[]}", erk.GetKindString(expectedError1)),
 				),
 			).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -286,7 +287,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 					"expected 2",
 				),
 			).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -310,7 +311,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 					"actual",
 				),
 			).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -334,7 +335,7 @@ func TestChainMatchesAllErrors(t *testing.T) {
 					expectedError2.Error(),
 				),
 			).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -397,7 +398,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 		err2 := errors.New("my error")
 
 		mockT.EXPECT().Fatalf(errorFormat, err1.Error(), err2.Error()).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -410,7 +411,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 		err1 := testError{Unique: 1, Message: "error message 1"}
 		err2 := testError{Unique: 2, Message: "error message 2"}
 		mockT.EXPECT().Fatalf(errorFormat, err1.Error(), err2.Error()).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -422,7 +423,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 
 		err := errors.New("my error")
 		mockT.EXPECT().Fatalf(errorFormat, err.Error(), "<nil>").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -434,7 +435,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 
 		err := errors.New("my error")
 		mockT.EXPECT().Fatalf(errorFormat, "<nil>", err.Error()).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -454,7 +455,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 			fmt.Sprintf("{KIND: \"%s\", MESSAGE: \"actual hi\", PARAMS: map[a:hi]}", erk.GetKindString(actualError)),
 			fmt.Sprintf("{KIND: \"%s\", RAW MESSAGE: \"expected {{.a}}\", PARAMS: map[]}", erk.GetKindString(expectedError)),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -473,7 +474,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 			fmt.Sprintf("{KIND: \"%s\", MESSAGE: \"actual hi\", PARAMS: map[a:hi]}", erk.GetKindString(actualError)),
 			fmt.Sprintf("{KIND: \"%s\", RAW MESSAGE: \"expected {{.a}}\", PARAMS: map[]}", erk.GetKindString(expectedError)),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -492,7 +493,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 			actualError.Error(),
 			fmt.Sprintf("{KIND: \"%s\", RAW MESSAGE: \"expected {{.a}}\", PARAMS: map[]}", erk.GetKindString(expectedError)),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -511,7 +512,7 @@ func sharedIsErrorTests(t *testing.T, run func(mockT *mock_testctx.MockT, chain
 			fmt.Sprintf("{KIND: \"%s\", MESSAGE: \"actual hi\", PARAMS: map[a:hi]}", erk.GetKindString(actualError)),
 			expectedError.Error(),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -533,7 +534,7 @@ func TestChainIsNotError(t *testing.T) {
 
 		err := errors.New("my error")
 		mockT.EXPECT().Fatalf("\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s", err.Error(), "<nil>").After(
-			mockT.EXPECT().Helper().Times(2),
+			mockT.EXPECT().Helper().Times(3),
 		)
 
 		ensure := ensure.New(mockT)
@@ -541,6 +542,287 @@ func TestChainIsNotError(t *testing.T) {
 	})
 }
 
+func TestErrorAs(t *testing.T) {
+	const failureFormat = "\nActual error does not have the expected type:\n\tActual:   %s\n\tExpected: %s\n\n\tError types:%s"
+
+	t.Run("when error has the type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		err := fmt.Errorf("wrapped: %w", &testStatusError{Status: 404})
+
+		statusErr := ensuring.ErrorAs[*testStatusError](ensure(err))
+		if statusErr == nil || statusErr.Status != 404 {
+			t.Fatalf("Expected the matched error to be returned, got: %v", statusErr)
+		}
+	})
+
+	t.Run("when error has an interface type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		err := fmt.Errorf("wrapped: %w", &testStatusError{Status: 500})
+
+		statusErr := ensuring.ErrorAs[interface {
+			error
+			StatusCode() int
+		}](ensure(err))
+		if statusErr == nil || statusErr.StatusCode() != 500 {
+			t.Fatalf("Expected the matched error to be returned, got: %v", statusErr)
+		}
+	})
+
+	t.Run("when no error in the chain has the type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		err := fmt.Errorf("wrapped: %w", errors.Join(errors.New("first"), fmt.Errorf("second: %w", testError{Message: "third"})))
+		mockT.EXPECT().Fatalf(
+			failureFormat,
+			err.Error(),
+			"*ensuring_test.testStatusError",
+			"\n\t  *fmt.wrapError\n\t    *errors.joinError\n\t      *errors.errorString\n\t      *fmt.wrapError\n\t        ensuring_test.testError",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		statusErr := ensuring.ErrorAs[*testStatusError](ensure(err))
+		if statusErr != nil {
+			t.Fatalf("Expected nil, got: %v", statusErr)
+		}
+	})
+
+	t.Run("when error is nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "<nil>", "*ensuring_test.testStatusError", "\n\t  <nil>").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.ErrorAs[*testStatusError](ensure(nil))
+	})
+
+	t.Run("when actual is not error type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		const val = "not an error"
+		mockT.EXPECT().Fatalf("Got type %T, expected an error", val).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.ErrorAs[*testStatusError](ensure(val))
+	})
+
+	t.Run("when negated and error has the type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		err := &testStatusError{Status: 404}
+		mockT.EXPECT().Fatalf(
+			"\nActual was expected NOT to %s\n\nACTUAL:\n%s",
+			"have an error of type *ensuring_test.testStatusError",
+			gomock.Any(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.ErrorAs[*testStatusError](ensure(err).Not())
+	})
+}
+
+func TestChainErrorContains(t *testing.T) {
+	const failureFormat = "\nActual error message does not contain the expected text:\n\tActual:   %s\n\tExpected: %q"
+
+	t.Run("when top level message contains text", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(fmt.Errorf("cannot open file: %w", errors.New("not found"))).ErrorContains("open file")
+	})
+
+	t.Run("when wrapped message contains text", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		err := &testHiddenWrapError{err: errors.Join(errors.New("first"), errors.New("second"))}
+		ensure(err).ErrorContains("second")
+	})
+
+	t.Run("when no message contains text", func(t *testing.T) {
+		type kind struct{ erk.DefaultKind }
+		err := erk.NewWith(kind{}, "cannot load {{.id}}", erk.Params{"id": 123})
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			failureFormat,
+			fmt.Sprintf("{KIND: \"%s\", MESSAGE: \"cannot load 123\", PARAMS: map[id:123]}", erk.GetKindString(err)),
+			"timeout",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).ErrorContains("timeout")
+	})
+
+	t.Run("when error is nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "<nil>", "timeout").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(nil).ErrorContains("timeout")
+	})
+
+	t.Run("when error is a typed nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "<nil>", "timeout").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		var err *testStatusError
+		ensure := ensure.New(mockT)
+		ensure(err).ErrorContains("timeout")
+	})
+
+	t.Run("when wrapped error is a typed nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "hidden", "timeout").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		var wrapped *testStatusError
+		ensure := ensure.New(mockT)
+		ensure(&testHiddenWrapError{err: wrapped}).ErrorContains("timeout")
+	})
+
+	t.Run("when actual is not error type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		const val = "timeout"
+		mockT.EXPECT().Fatalf("Got type %T, expected an error", val).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(val).ErrorContains("timeout")
+	})
+}
+
+func TestChainErrorMatchesRegexp(t *testing.T) {
+	const failureFormat = "\nActual error message does not match the regular expression:\n\tActual:   %s\n\tExpected: %s"
+
+	t.Run("when top level message matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("status 404")).ErrorMatchesRegexp(`^status 4\d\d$`)
+	})
+
+	t.Run("when wrapped message matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(&testHiddenWrapError{err: errors.New("status 404")}).ErrorMatchesRegexp(`^status 4\d\d$`)
+	})
+
+	t.Run("when no message matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "wrapped: status 500", `^status 4\d\d$`).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(fmt.Errorf("wrapped: %w", errors.New("status 500"))).ErrorMatchesRegexp(`^status 4\d\d$`)
+	})
+
+	t.Run("when error is nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "<nil>", "status").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(nil).ErrorMatchesRegexp("status")
+	})
+
+	t.Run("when error is a typed nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(failureFormat, "<nil>", "status").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		var err *testStatusError
+		ensure := ensure.New(mockT)
+		ensure(err).ErrorMatchesRegexp("status")
+	})
+
+	t.Run("when actual is not error type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		const val = 404
+		mockT.EXPECT().Fatalf("Got type %T, expected an error", val).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(val).ErrorMatchesRegexp("status")
+	})
+
+	t.Run("when pattern is empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Cannot match against an empty pattern").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("status")).ErrorMatchesRegexp("")
+	})
+
+	t.Run("when pattern is invalid", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Unable to compile regular expression: %s\nERROR: %v", "(", gomock.Any()).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("status")).ErrorMatchesRegexp("(")
+	})
+}
+
+// testHiddenWrapError wraps an error without including its message.
+type testHiddenWrapError struct {
+	err error
+}
+
+func (e *testHiddenWrapError) Error() string {
+	return "hidden"
+}
+
+func (e *testHiddenWrapError) Unwrap() error {
+	return e.err
+}
+
+type testStatusError struct {
+	Status int
+}
+
+func (e *testStatusError) Error() string {
+	return fmt.Sprintf("status %d", e.Status)
+}
+
+func (e *testStatusError) StatusCode() int {
+	return e.Status
+}
+
 type testError struct {
 	Message string
 	Unique  int
@@ -0,0 +1,111 @@
+package ensuring
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	goldenDir       = "testdata"
+	goldenUpdateEnv = "ENSURE_UPDATE_GOLDEN"
+)
+
+// MatchesGoldenFile ensures the actual value matches the contents of the golden file at path.
+// Relative paths are resolved within the testdata directory of the package under test, and absolute paths are used as is.
+//
+// Strings and []byte values are compared directly. Any other value is marshaled to indented JSON before it is compared.
+//
+// When the ENSURE_UPDATE_GOLDEN environment variable is set to a true value (for example, 1), the golden file
+// is written with the actual value instead, and any missing directories are created.
+//
+// For example:
+//
+//	ensure(renderedTemplate).MatchesGoldenFile("welcome_email.golden") // Compared with testdata/welcome_email.golden
+func (c *Chain) MatchesGoldenFile(path string) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("match golden file " + path)()
+
+	goldenPath := path
+	if !filepath.IsAbs(goldenPath) {
+		goldenPath = filepath.Join(goldenDir, goldenPath)
+	}
+
+	actual, err := goldenContents(c.actual)
+	if err != nil {
+		c.invalid("Cannot marshal actual value of type %T to JSON for golden file %s: %v", c.actual, goldenPath, err)
+		return
+	}
+
+	// Negated golden files are not updated, since the actual value is expected to differ
+	if shouldUpdateGoldenFiles() && !c.negated {
+		if err := writeGoldenFile(goldenPath, actual); err != nil {
+			c.invalid("Cannot update golden file %s: %v", goldenPath, err)
+			return
+		}
+
+		c.t.Logf("Updated golden file: %s", goldenPath)
+		return
+	}
+
+	expected, err := os.ReadFile(goldenPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		c.invalid("Golden file %s does not exist. To create it, rerun the test with %s=1", goldenPath, goldenUpdateEnv)
+		return
+	}
+
+	if err != nil {
+		c.invalid("Cannot read golden file %s: %v", goldenPath, err)
+		return
+	}
+
+	if bytes.Equal(actual, expected) {
+		return
+	}
+
+	// Compare values of the same type as the actual value, so the failure is formatted like Equals
+	var actualValue, expectedValue interface{} = string(actual), string(expected)
+	if _, ok := c.actual.([]byte); ok {
+		actualValue, expectedValue = actual, expected
+	}
+
+	format, args := formatInequalityMessage(checkEquality(actualValue, expectedValue, nil), actualValue, expectedValue)
+	c.fail(
+		"\nActual does not match golden file %s. To update it, rerun the test with %s=1\n"+format,
+		append([]interface{}{goldenPath, goldenUpdateEnv}, args...)...,
+	)
+}
+
+func goldenContents(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	}
+
+	contents, err := json.MarshalIndent(value, "", indent)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(contents, '\n'), nil
+}
+
+func shouldUpdateGoldenFiles() bool {
+	update, _ := strconv.ParseBool(os.Getenv(goldenUpdateEnv))
+	return update
+}
+
+func writeGoldenFile(path string, contents []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd // Standard directory permissions
+		return err
+	}
+
+	return os.WriteFile(path, contents, 0o644) //nolint:gosec,mnd // Golden files are not sensitive
+}
@@ -0,0 +1,150 @@
+package ensuring_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainMatchesGoldenFile(t *testing.T) {
+	t.Run("when string matches golden file in testdata", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure("Hello, world!\n").MatchesGoldenFile("greeting.golden")
+	})
+
+	t.Run("when []byte matches golden file", func(t *testing.T) {
+		path := writeTestGoldenFile(t, "Hello, world!\n")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure([]byte("Hello, world!\n")).MatchesGoldenFile(path)
+	})
+
+	t.Run("when JSON value matches golden file", func(t *testing.T) {
+		path := writeTestGoldenFile(t, "{\n  \"Name\": \"John\",\n  \"Tags\": [\n    \"a\"\n  ]\n}\n")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(examplePayload{Name: "John", Tags: []string{"a"}}).MatchesGoldenFile(path)
+	})
+
+	t.Run("when string does not match golden file", func(t *testing.T) {
+		path := writeTestGoldenFile(t, "Hello,\nworld!\n")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not match golden file %s. To update it, rerun the test with %s=1\n"+
+				"\nActual %s does not equal expected %s:\n\nDIFF (-actual +expected):\n%s",
+			path,
+			"ENSURE_UPDATE_GOLDEN",
+			"string",
+			"string",
+			"  @@ -1,2 +1,2 @@\n   Hello,\n  -there!\n  +world!",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("Hello,\nthere!\n").MatchesGoldenFile(path)
+	})
+
+	t.Run("when golden file does not exist", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing.golden")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Golden file %s does not exist. To create it, rerun the test with %s=1",
+			path,
+			"ENSURE_UPDATE_GOLDEN",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("Hello").MatchesGoldenFile(path)
+	})
+
+	t.Run("when actual cannot be marshaled", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "value.golden")
+		actual := make(chan int)
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Cannot marshal actual value of type %T to JSON for golden file %s: %v",
+			actual,
+			path,
+			gomock.Any(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(actual).MatchesGoldenFile(path)
+	})
+
+	t.Run("when updating golden files", func(t *testing.T) {
+		t.Setenv("ENSURE_UPDATE_GOLDEN", "1")
+
+		t.Run("creates missing golden file and directories", func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "nested", "dir", "new.golden")
+
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Logf("Updated golden file: %s", path).After(
+				mockT.EXPECT().Helper(),
+			)
+
+			ensure := ensure.New(mockT)
+			ensure("Hello, world!\n").MatchesGoldenFile(path)
+
+			ensureGoldenFileContents(t, path, "Hello, world!\n")
+		})
+
+		t.Run("overwrites existing golden file", func(t *testing.T) {
+			path := writeTestGoldenFile(t, "Old contents\n")
+
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Logf("Updated golden file: %s", path).After(
+				mockT.EXPECT().Helper(),
+			)
+
+			ensure := ensure.New(mockT)
+			ensure(map[string]int{"count": 1}).MatchesGoldenFile(path)
+
+			ensureGoldenFileContents(t, path, "{\n  \"count\": 1\n}\n")
+		})
+	})
+}
+
+func writeTestGoldenFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.golden")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("unable to write golden file: %v", err)
+	}
+
+	return path
+}
+
+func ensureGoldenFileContents(t *testing.T, path, expected string) {
+	t.Helper()
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read golden file: %v", err)
+	}
+
+	if string(contents) != expected {
+		t.Errorf("expected golden file to contain %q, got %q", expected, string(contents))
+	}
+}
@@ -0,0 +1,216 @@
+package ensuring
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// maxIteratorLength is the maximum number of values collected from an iterator before the assertion fails.
+// It prevents infinite iterators from hanging the test.
+const maxIteratorLength = 100_000
+
+// KeyValue is a pair of values yielded by an [iter.Seq2]. Iterators of pairs are collected into a []KeyValue,
+// so []KeyValue can be used as the expected value for iterators of pairs.
+type KeyValue struct {
+	Key   interface{}
+	Value interface{}
+}
+
+//nolint:gochecknoglobals // Only read internally.
+var keyValueSliceType = reflect.TypeOf([]KeyValue{})
+
+// YieldsExactly ensures the actual value is an iterator, like [iter.Seq] or [iter.Seq2], that yields exactly the
+// expected values, in order. Values yielded by an [iter.Seq2] are compared as [KeyValue] pairs.
+// Values are compared the same way as [Chain.Equals].
+//
+// It also ensures the iterator stops cleanly when yield returns false, by stopping the iterator after the first
+// value, and after the second to last value. Stopping after every value would run the iterator once per value.
+//
+// For example:
+//
+//	ensure(slices.Values([]int{1, 2})).YieldsExactly(1, 2)
+//	ensure(maps.All(map[string]int{"a": 1})).YieldsExactly(ensuring.KeyValue{Key: "a", Value: 1})
+func (c *Chain) YieldsExactly(expected ...interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("yield exactly", expected)()
+
+	iterator := reflect.ValueOf(c.actual)
+	if iteratorKindOf(iterator) == notIterator {
+		c.invalid("Got type %T, expected an iterator, like iter.Seq or iter.Seq2", c.actual)
+		return
+	}
+
+	all := runIterator(iterator, maxIteratorLength+1)
+	if len(all.values) > maxIteratorLength {
+		c.invalid("Iterator yielded more than %d values", maxIteratorLength)
+		return
+	}
+
+	if expected == nil {
+		expected = []interface{}{}
+	}
+
+	yielded := make([]interface{}, 0, len(all.values))
+	for _, value := range all.values {
+		yielded = append(yielded, value.Interface())
+	}
+
+	if differences := checkEquality(yielded, expected, nil); len(differences) > 0 {
+		c.fail(
+			"\nIterator did not yield exactly the expected values:%s\n\nYIELDED:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			prettyFormat(yielded),
+			prettyFormat(expected),
+		)
+		return
+	}
+
+	for _, stopAfter := range stopPoints(len(yielded)) {
+		if stopped := runIterator(iterator, stopAfter); stopped.continuedAfterStop {
+			c.fail(
+				"\nIterator continued after yield returned false:\n\tStopped after: %d value(s)\n\tThen yielded:  %s",
+				stopAfter,
+				prettyFormatValue(stopped.continuedWith.Interface()),
+			)
+			return
+		}
+	}
+}
+
+// stopPoints returns the number of values after which to stop an iterator that yields length values.
+// The iterator is stopped after the first value, and after the second to last value, so the start and end of
+// the loop in the iterator are both checked.
+func stopPoints(length int) []int {
+	if length == 0 {
+		return nil
+	}
+
+	if secondToLast := length - 1; secondToLast > 1 {
+		return []int{1, secondToLast}
+	}
+
+	return []int{1}
+}
+
+type iteratorKind int
+
+const (
+	notIterator iteratorKind = iota
+	seqIterator
+	seq2Iterator
+)
+
+// iteratorKindOf returns the kind of iterator, based on the signature of the function, so named types are supported.
+func iteratorKindOf(value reflect.Value) iteratorKind {
+	if value.Kind() != reflect.Func {
+		return notIterator
+	}
+
+	iteratorType := value.Type()
+	if iteratorType.NumIn() != 1 || iteratorType.NumOut() != 0 || iteratorType.IsVariadic() {
+		return notIterator
+	}
+
+	yieldType := iteratorType.In(0)
+	if yieldType.Kind() != reflect.Func || yieldType.NumOut() != 1 || yieldType.Out(0).Kind() != reflect.Bool ||
+		yieldType.IsVariadic() {
+		return notIterator
+	}
+
+	switch yieldType.NumIn() {
+	case 1:
+		return seqIterator
+	case 2: //nolint:mnd // Number of values yielded by iter.Seq2
+		return seq2Iterator
+	default:
+		return notIterator
+	}
+}
+
+type iteration struct {
+	// values contains the yielded values. Pairs yielded by iter.Seq2 are stored as a KeyValue.
+	values []reflect.Value
+
+	// continuedAfterStop is true if yield was called after it returned false, and continuedWith is the first
+	// value yielded after that.
+	continuedAfterStop bool
+	continuedWith      reflect.Value
+}
+
+// runIterator calls the iterator, and stops it once limit values were yielded. A nil iterator yields no values.
+func runIterator(iterator reflect.Value, limit int) iteration {
+	result := iteration{}
+	if iterator.IsNil() {
+		return result
+	}
+
+	kind := iteratorKindOf(iterator)
+	yieldType := iterator.Type().In(0)
+
+	yield := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+		value := args[0]
+		if kind == seq2Iterator {
+			value = reflect.ValueOf(KeyValue{Key: args[0].Interface(), Value: args[1].Interface()})
+		}
+
+		if len(result.values) >= limit {
+			if !result.continuedAfterStop {
+				result.continuedAfterStop = true
+				result.continuedWith = value
+			}
+
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+
+		result.values = append(result.values, value)
+		return []reflect.Value{reflect.ValueOf(len(result.values) < limit)}
+	})
+
+	iterator.Call([]reflect.Value{yield})
+	return result
+}
+
+// collectIterator collects the values yielded by an iterator into a slice, so it can be compared like a slice.
+// Values yielded by an iter.Seq are collected into a slice of the yielded type, and pairs yielded by an
+// iter.Seq2 are collected into a []KeyValue. If value is not an iterator, it is returned as is.
+func collectIterator(value interface{}) (interface{}, error) {
+	iterator := reflect.ValueOf(value)
+	kind := iteratorKindOf(iterator)
+	if kind == notIterator {
+		return value, nil
+	}
+
+	all := runIterator(iterator, maxIteratorLength+1)
+	if len(all.values) > maxIteratorLength {
+		//lint:ignore ST1005 Only used internally
+		return nil, fmt.Errorf("Iterator yielded more than %d values", maxIteratorLength) //nolint:err113 // Only used internally
+	}
+
+	sliceType := keyValueSliceType
+	if kind == seqIterator {
+		sliceType = reflect.SliceOf(iterator.Type().In(0).In(0))
+	}
+
+	collected := reflect.MakeSlice(sliceType, 0, len(all.values))
+	for _, v := range all.values {
+		collected = reflect.Append(collected, v)
+	}
+
+	return collected.Interface(), nil
+}
+
+// collectIterators collects both actual and expected, if they are iterators.
+func collectIterators(actual, expected interface{}) (interface{}, interface{}, error) {
+	actual, err := collectIterator(actual)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expected, err = collectIterator(expected)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actual, expected, nil
+}
@@ -0,0 +1,242 @@
+package ensuring_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+)
+
+func TestChainYieldsExactly(t *testing.T) {
+	t.Run("when iter.Seq yields the expected values", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]string{"abc", "xyz"})).YieldsExactly("abc", "xyz")
+	})
+
+	t.Run("when iter.Seq2 yields the expected pairs", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.All([]string{"abc", "xyz"})).YieldsExactly(
+			ensuring.KeyValue{Key: 0, Value: "abc"},
+			ensuring.KeyValue{Key: 1, Value: "xyz"},
+		)
+	})
+
+	t.Run("when iterator yields nothing", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		var seq iter.Seq[int]
+
+		ensure := ensure.New(mockT)
+		ensure(seq).YieldsExactly()
+	})
+
+	t.Run("when iterator yields different values", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nIterator did not yield exactly the expected values:%s\n\nYIELDED:\n%s\n\nEXPECTED:\n%s",
+			"\n - [1]: 2 != 3\n - [2]: 3 != <missing element>",
+			"  []interface {}{\n      int(1),\n      int(2),\n      int(3),\n  }",
+			"  []interface {}{\n      int(1),\n      int(3),\n  }",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]int{1, 2, 3})).YieldsExactly(1, 3)
+	})
+
+	t.Run("when iterator continues after yield returns false", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nIterator continued after yield returned false:\n\tStopped after: %d value(s)\n\tThen yielded:  %s",
+			1,
+			"int(2)",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		seq := func(yield func(int) bool) {
+			for _, v := range []int{1, 2, 3} {
+				yield(v) // Ignores the result of yield
+			}
+		}
+
+		ensure := ensure.New(mockT)
+		ensure(seq).YieldsExactly(1, 2, 3)
+	})
+
+	t.Run("when iterator continues after yield returns false near the end", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nIterator continued after yield returned false:\n\tStopped after: %d value(s)\n\tThen yielded:  %s",
+			3,
+			"int(4)",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		values := []int{1, 2, 3, 4}
+		seq := func(yield func(int) bool) {
+			for i, v := range values {
+				if !yield(v) && i < len(values)-2 { // Only stops early in the loop
+					return
+				}
+			}
+		}
+
+		ensure := ensure.New(mockT)
+		ensure(seq).YieldsExactly(1, 2, 3, 4)
+	})
+
+	t.Run("when iterator is infinite", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Iterator yielded more than %d values", 100_000).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		seq := func(yield func(int) bool) {
+			for i := 0; yield(i); i++ {
+			}
+		}
+
+		ensure := ensure.New(mockT)
+		ensure(seq).YieldsExactly(1)
+	})
+
+	t.Run("when actual is not an iterator", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected an iterator, like iter.Seq or iter.Seq2", []int{1}).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]int{1}).YieldsExactly(1)
+	})
+}
+
+func TestChainIteratorSupport(t *testing.T) {
+	t.Run("IsEmpty when iterator is empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]int{})).IsEmpty()
+	})
+
+	t.Run("IsEmpty when iterator is not empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got %+v with length %d, expected it to be empty", []int{1, 2}, 2).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]int{1, 2})).IsEmpty()
+	})
+
+	t.Run("IsNotEmpty when iter.Seq2 is not empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.All([]int{1})).IsNotEmpty()
+	})
+
+	t.Run("IsNotEmpty when iterator is infinite", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Iterator yielded more than 100000 values").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		seq := func(yield func(int) bool) {
+			for yield(1) {
+			}
+		}
+
+		ensure := ensure.New(mockT)
+		ensure(seq).IsNotEmpty()
+	})
+
+	t.Run("Contains when iterator contains value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]string{"abc", "xyz"})).Contains("xyz")
+	})
+
+	t.Run("Contains when iter.Seq2 contains pair", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.All([]string{"abc", "xyz"})).Contains(ensuring.KeyValue{Key: 1, Value: "xyz"})
+	})
+
+	t.Run("Contains when iterator does not contain value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Actual does not contain expected:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN:\n%s",
+			`  []string{"abc", "xyz"}`,
+			`  "qwerty"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]string{"abc", "xyz"})).Contains("qwerty")
+	})
+
+	t.Run("DoesNotContain when iterator contains value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Actual contains expected, but did not expect it to:\n\nACTUAL:\n%s\n\nEXPECTED NOT TO CONTAIN:\n%s",
+			`  []string{"abc", "xyz"}`,
+			`  "xyz"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]string{"abc", "xyz"})).DoesNotContain("xyz")
+	})
+
+	t.Run("Equals when iterator equals slice", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]int{1, 2})).Equals([]int{1, 2})
+	})
+
+	t.Run("Equals when iterators are equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(slices.All([]int{1, 2})).Equals(slices.All([]int{1, 2}))
+	})
+
+	t.Run("Equals when iterator does not equal slice", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\n%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"Actual does not equal expected:\n - [1]: 2 != 3",
+			"  []int{1, 2}",
+			"  []int{1, 3}",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(slices.Values([]int{1, 2})).Equals([]int{1, 3})
+	})
+}
@@ -0,0 +1,114 @@
+package ensuring
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/JosiahWitt/ensure/internal/diff"
+	"github.com/kr/text"
+)
+
+var errTrailingJSONData = errors.New("unexpected data after top-level value")
+
+// EqualsJSON ensures the actual JSON value is semantically equal to the expected JSON value.
+// Object key order and whitespace are ignored, and numbers are compared by value.
+// Differences are reported with JSON paths, for example: $.items[2].price.
+//
+// Strings, []byte, and json.RawMessage values are treated as JSON documents.
+// Any other value is marshaled to JSON before it is compared.
+//
+// For example:
+//
+//	ensure(responseBody).EqualsJSON(`{"id": 123, "tags": ["a", "b"]}`)
+//	ensure(responseBody).EqualsJSON(map[string]interface{}{"id": 123, "tags": []string{"a", "b"}})
+func (c *Chain) EqualsJSON(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("equal JSON", expected)()
+
+	actualJSON, err := decodeJSON(c.actual)
+	if err != nil {
+		c.invalid("\nActual %s\n\nACTUAL:\n%s", err.Error(), formatJSONInput(c.actual))
+		return
+	}
+
+	expectedJSON, err := decodeJSON(expected)
+	if err != nil {
+		c.invalid("\nExpected %s\n\nEXPECTED:\n%s", err.Error(), formatJSONInput(expected))
+		return
+	}
+
+	differences := diff.JSON(actualJSON, expectedJSON)
+	if len(differences) > 0 {
+		c.fail(
+			"\nActual JSON does not equal expected JSON:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			formatIndentedJSON(actualJSON),
+			formatIndentedJSON(expectedJSON),
+		)
+	}
+}
+
+// decodeJSON decodes the JSON document in value, or marshals value to JSON and decodes it.
+// Numbers are decoded as json.Number, so they can be compared exactly.
+func decodeJSON(value interface{}) (interface{}, error) {
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	case json.RawMessage:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("cannot be marshaled to JSON: %w", err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("is not valid JSON: %w", err)
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("is not valid JSON: %w", errTrailingJSONData)
+	}
+
+	return decoded, nil
+}
+
+// formatJSONInput formats value as text if it is a JSON document, since it may not be valid JSON.
+func formatJSONInput(value interface{}) string {
+	if raw, ok := value.(json.RawMessage); ok {
+		return indent + prettyFormatString(string(raw), "json.RawMessage")
+	}
+
+	if str, valType, ok := isStringLike(value); ok {
+		return indent + prettyFormatString(str, valType)
+	}
+
+	return prettyFormat(value)
+}
+
+func formatIndentedJSON(value interface{}) string {
+	var b bytes.Buffer
+
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+
+	if err := encoder.Encode(value); err != nil {
+		return err.Error() // Shouldn't be possible, since the value was decoded from JSON
+	}
+
+	return text.Indent(strings.TrimSuffix(b.String(), "\n"), indent)
+}
@@ -0,0 +1,110 @@
+package ensuring_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"go.uber.org/mock/gomock"
+)
+
+type examplePayload struct {
+	Name string
+	Tags []string
+}
+
+func TestChainEqualsJSON(t *testing.T) {
+	const errorMessageFormat = "\nActual JSON does not equal expected JSON:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s"
+
+	t.Run("when equal", func(t *testing.T) {
+		table := []struct {
+			Name     string
+			Actual   interface{}
+			Expected interface{}
+		}{
+			{
+				Name:     "strings with different key order and whitespace",
+				Actual:   `{"a": 1, "b": [1, 2]}`,
+				Expected: "{\n  \"b\": [1,2],\n  \"a\": 1\n}",
+			},
+			{
+				Name:     "[]byte and json.RawMessage",
+				Actual:   []byte(`{"a": 1}`),
+				Expected: json.RawMessage(`{"a":1}`),
+			},
+			{
+				Name:     "string and marshalable value",
+				Actual:   `{"Name": "John", "Tags": ["a"]}`,
+				Expected: examplePayload{Name: "John", Tags: []string{"a"}},
+			},
+			{
+				Name:     "marshalable values",
+				Actual:   map[string]interface{}{"price": 10},
+				Expected: map[string]float64{"price": 10.0},
+			},
+		}
+
+		for _, entry := range table {
+			t.Run(entry.Name, func(t *testing.T) {
+				mockT := setupMockTWithCleanupCheck(t)
+				mockT.EXPECT().Helper()
+
+				ensure := ensure.New(mockT)
+				ensure(entry.Actual).EqualsJSON(entry.Expected)
+			})
+		}
+	})
+
+	t.Run("when not equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(errorMessageFormat,
+			"\n - $.items[1].price: 2 != 2.5\n - $.total: 3 != <missing key>",
+			"  {\n    \"items\": [\n      {\n        \"price\": 1\n      },\n      {\n        \"price\": 2\n      }\n    ],\n    \"total\": 3\n  }",
+			"  {\n    \"items\": [\n      {\n        \"price\": 1\n      },\n      {\n        \"price\": 2.5\n      }\n    ]\n  }",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(`{"total": 3, "items": [{"price": 1}, {"price": 2}]}`).EqualsJSON(`{"items": [{"price": 1}, {"price": 2.5}]}`)
+	})
+
+	t.Run("when actual is invalid JSON", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual %s\n\nACTUAL:\n%s",
+			"is not valid JSON: invalid character '}' looking for beginning of object key string",
+			`  "{\"a\": 1,}"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(`{"a": 1,}`).EqualsJSON(`{"a": 1}`)
+	})
+
+	t.Run("when expected is invalid JSON", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nExpected %s\n\nEXPECTED:\n%s",
+			"is not valid JSON: unexpected data after top-level value",
+			`  []byte("{\"a\": 1} {}")`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(`{"a": 1}`).EqualsJSON([]byte(`{"a": 1} {}`))
+	})
+
+	t.Run("when expected cannot be marshaled", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nExpected %s\n\nEXPECTED:\n%s",
+			"cannot be marshaled to JSON: json: unsupported type: func()",
+			gomock.Any(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(`{"a": 1}`).EqualsJSON(func() {})
+	})
+}
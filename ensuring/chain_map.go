@@ -0,0 +1,185 @@
+package ensuring
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+// HasKey ensures that the actual map has the expected key.
+//
+// For example:
+//
+//	ensure(map[string]int{"a": 1}).HasKey("a") // Succeeds
+//	ensure(map[string]int{"a": 1}).HasKey("b") // Fails
+func (c *Chain) HasKey(key interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have key", key)()
+
+	actual, keyValue, err := mapAndKey(c.actual, key)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	if !actual.MapIndex(keyValue).IsValid() {
+		c.fail("Actual does not have key %s\n\nACTUAL KEYS:\n%s", diff.FormatValue(key), formatMapKeys(actual))
+	}
+}
+
+// DoesNotHaveKey ensures that the actual map does not have the key.
+//
+// For example:
+//
+//	ensure(map[string]int{"a": 1}).DoesNotHaveKey("a") // Fails
+//	ensure(map[string]int{"a": 1}).DoesNotHaveKey("b") // Succeeds
+func (c *Chain) DoesNotHaveKey(key interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("not have key", key)()
+
+	actual, keyValue, err := mapAndKey(c.actual, key)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	if value := actual.MapIndex(keyValue); value.IsValid() {
+		c.fail(
+			"Actual has key %s, but did not expect it to\n\nVALUE:\n%s",
+			diff.FormatValue(key),
+			prettyFormat(value.Interface()),
+		)
+	}
+}
+
+// HasEntry ensures that the actual map has the expected key, with a value that equals the expected value.
+// The values are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(map[string]int{"a": 1}).HasEntry("a", 1) // Succeeds
+//	ensure(map[string]int{"a": 1}).HasEntry("a", 2) // Fails
+func (c *Chain) HasEntry(key, value interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("have entry for key "+diff.FormatValue(key), value)()
+
+	actual, keyValue, err := mapAndKey(c.actual, key)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	actualValue := actual.MapIndex(keyValue)
+	if !actualValue.IsValid() {
+		c.fail("Actual does not have key %s\n\nACTUAL KEYS:\n%s", diff.FormatValue(key), formatMapKeys(actual))
+		return
+	}
+
+	if differences := checkEquality(actualValue.Interface(), value, nil); len(differences) > 0 {
+		c.fail(
+			"\nActual value for key %s does not equal expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			diff.FormatValue(key),
+			formatDifferences(differences),
+			prettyFormat(actualValue.Interface()),
+			prettyFormat(value),
+		)
+	}
+}
+
+// ContainsSubset ensures that the actual map contains every key in the expected map, with values
+// that equal the expected values. Keys that are only in the actual map are ignored.
+// The expected map must have the same type as the actual map, and values are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(map[string]int{"a": 1, "b": 2}).ContainsSubset(map[string]int{"a": 1}) // Succeeds
+//	ensure(map[string]int{"a": 1, "b": 2}).ContainsSubset(map[string]int{"a": 2}) // Fails
+func (c *Chain) ContainsSubset(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("contain subset", expected)()
+
+	actual, err := mapOf(c.actual)
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	expectedMap := reflect.ValueOf(expected)
+	if expectedMap.Kind() != reflect.Map || expectedMap.Type() != actual.Type() {
+		c.invalid("Expected has type %T, but actual has type %T", expected, c.actual)
+		return
+	}
+
+	// Only keep the expected keys in actual, so the remaining keys can be compared with expected
+	actualSubset := reflect.MakeMapWithSize(actual.Type(), expectedMap.Len())
+	for _, key := range expectedMap.MapKeys() {
+		if value := actual.MapIndex(key); value.IsValid() {
+			actualSubset.SetMapIndex(key, value)
+		}
+	}
+
+	if differences := checkEquality(actualSubset.Interface(), expected, nil); len(differences) > 0 {
+		c.fail(
+			"\nActual does not contain expected subset:%s\n\nACTUAL KEYS:\n%s",
+			formatDifferences(differences),
+			formatMapKeys(actual),
+		)
+	}
+}
+
+func mapOf(value interface{}) (reflect.Value, error) {
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Map {
+		//lint:ignore ST1005 Only used internally
+		return reflect.Value{}, fmt.Errorf("Got type %T, expected map", value) //nolint:err113 // Only used internally
+	}
+
+	return reflectValue, nil
+}
+
+func mapAndKey(value, key interface{}) (reflect.Value, reflect.Value, error) {
+	m, err := mapOf(value)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, err
+	}
+
+	keyType := m.Type().Key()
+	if key == nil {
+		//nolint:exhaustive // Only kinds that can be nil are relevant
+		switch keyType.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Chan:
+			return m, reflect.Zero(keyType), nil
+		default:
+			//lint:ignore ST1005 Only used internally
+			return reflect.Value{}, reflect.Value{}, fmt.Errorf("Key is nil, but the map keys have type %s", keyType) //nolint:err113 // Only used internally
+		}
+	}
+
+	keyValue := reflect.ValueOf(key)
+	if !keyValue.Type().AssignableTo(keyType) {
+		//lint:ignore ST1005 Only used internally
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("Key has type %T, but the map keys have type %s", key, keyType) //nolint:err113 // Only used internally
+	}
+
+	return m, keyValue, nil
+}
+
+func formatMapKeys(m reflect.Value) string {
+	keys := diff.SortedMapKeys(m)
+	if len(keys) == 0 {
+		return indent + "(none)"
+	}
+
+	formattedKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formattedKeys = append(formattedKeys, indent+diff.FormatValue(key.Interface()))
+	}
+
+	return strings.Join(formattedKeys, "\n")
+}
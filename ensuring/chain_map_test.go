@@ -0,0 +1,209 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+)
+
+func TestChainHasKey(t *testing.T) {
+	t.Run("when key exists", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1}).HasKey("a")
+	})
+
+	t.Run("when nil key exists", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(map[interface{}]int{nil: 1}).HasKey(nil)
+	})
+
+	t.Run("when key does not exist", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual does not have key %s\n\nACTUAL KEYS:\n%s", `"b"`, "  \"a\"\n  \"c\"\n  \"d\"").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"d": 4, "a": 1, "c": 3}).HasKey("b")
+	})
+
+	t.Run("when map is empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual does not have key %s\n\nACTUAL KEYS:\n%s", "1", "  (none)").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[int]string{}).HasKey(1)
+	})
+
+	t.Run("when not a map", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type []string, expected map").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]string{"a"}).HasKey("a")
+	})
+
+	t.Run("when key has a different type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Key has type int, but the map keys have type string").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1}).HasKey(1)
+	})
+
+	t.Run("when key is nil and map keys cannot be nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Key is nil, but the map keys have type string").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1}).HasKey(nil)
+	})
+}
+
+func TestChainDoesNotHaveKey(t *testing.T) {
+	t.Run("when key does not exist", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1}).DoesNotHaveKey("b")
+	})
+
+	t.Run("when key exists", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual has key %s, but did not expect it to\n\nVALUE:\n%s", `"a"`, "  int(1)").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1}).DoesNotHaveKey("a")
+	})
+
+	t.Run("when not a map", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type string, expected map").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").DoesNotHaveKey("a")
+	})
+}
+
+func TestChainHasEntry(t *testing.T) {
+	t.Run("when entry exists", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]ExamplePerson{"john": {Name: "John"}}).HasEntry("john", ExamplePerson{Name: "John"})
+	})
+
+	t.Run("when key does not exist", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Actual does not have key %s\n\nACTUAL KEYS:\n%s", `"sam"`, "  \"john\"").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]ExamplePerson{"john": {Name: "John"}}).HasEntry("sam", ExamplePerson{Name: "Sam"})
+	})
+
+	t.Run("when value does not equal expected", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual value for key %s does not equal expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			`"john"`,
+			"\n - Email: \"john@example.com\" != \"john@example.org\"",
+			ExamplePerson{Name: "John", Email: "john@example.com"}.ExpectedOutput(),
+			ExamplePerson{Name: "John", Email: "john@example.org"}.ExpectedOutput(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]ExamplePerson{"john": {Name: "John", Email: "john@example.com"}}).
+			HasEntry("john", ExamplePerson{Name: "John", Email: "john@example.org"})
+	})
+}
+
+func TestChainContainsSubset(t *testing.T) {
+	t.Run("when subset is contained", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1, "b": 2, "c": 3}).ContainsSubset(map[string]int{"a": 1, "c": 3})
+	})
+
+	t.Run("when subset is empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1}).ContainsSubset(map[string]int{})
+	})
+
+	t.Run("when values are different", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not contain expected subset:%s\n\nACTUAL KEYS:\n%s",
+			"\n - [\"a\"].Name: \"John\" != \"Sam\"",
+			"  \"a\"\n  \"b\"",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]ExamplePerson{"a": {Name: "John"}, "b": {Name: "Bob"}}).
+			ContainsSubset(map[string]ExamplePerson{"a": {Name: "Sam"}})
+	})
+
+	t.Run("when keys are missing", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not contain expected subset:%s\n\nACTUAL KEYS:\n%s",
+			"\n - [\"c\"]: <missing key> != 3\n - [\"d\"]: <missing key> != 4",
+			"  \"a\"\n  \"b\"",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{"a": 1, "b": 2}).ContainsSubset(map[string]int{"a": 1, "c": 3, "d": 4})
+	})
+
+	t.Run("when not a map", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type []int, expected map").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]int{1}).ContainsSubset(map[string]int{})
+	})
+
+	t.Run("when expected has a different type", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Expected has type %T, but actual has type %T", map[string]int64{}, map[string]int{}).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(map[string]int{}).ContainsSubset(map[string]int64{})
+	})
+}
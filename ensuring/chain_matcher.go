@@ -0,0 +1,37 @@
+package ensuring
+
+import "github.com/kr/text"
+
+// Matches ensures the actual value matches the [Matcher].
+//
+// For example:
+//
+//	ensure("abc").Matches(ensuring.HasPrefix("ab")) // Succeeds
+//	ensure("abc").Matches(ensuring.Len(2)) // Fails
+func (c *Chain) Matches(m Matcher) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("match " + describeMatcher(m))()
+
+	if m == nil {
+		c.invalid("Matcher is nil")
+		return
+	}
+
+	if !m.Matches(c.actual) {
+		c.fail(
+			"\nActual does not match: %s\n\nMISMATCH:\n%s\n\nACTUAL:\n%s",
+			m.String(),
+			text.Indent(m.Explain(c.actual), indent),
+			prettyFormat(c.actual),
+		)
+	}
+}
+
+func describeMatcher(m Matcher) string {
+	if m == nil {
+		return "<nil matcher>"
+	}
+
+	return m.String()
+}
@@ -0,0 +1,54 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainMatches(t *testing.T) {
+	t.Run("when matching", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Matches(ensuring.AllOf(ensuring.HasPrefix("a"), ensuring.Len(3)))
+	})
+
+	t.Run("when not matching", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not match: %s\n\nMISMATCH:\n%s\n\nACTUAL:\n%s",
+			`all of (has prefix "x", has length 2)`,
+			"  \"abc\" does not have prefix \"x\"\n  has length 3, expected 2",
+			`  "abc"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Matches(ensuring.AllOf(ensuring.HasPrefix("x"), ensuring.Len(2)))
+	})
+
+	t.Run("when matcher is nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Matcher is nil").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Matches(nil)
+	})
+
+	t.Run("when used as a gomock matcher", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(ensuring.HasPrefix("\nActual does not match"), gomock.Any(), gomock.Any(), gomock.Any()).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Matches(ensuring.Len(2))
+	})
+}
@@ -0,0 +1,94 @@
+package ensuring
+
+import "strings"
+
+// Not negates the assertion chained after it, so it fails when the assertion would succeed, and succeeds when
+// the assertion would fail. Failures caused by invalid usage, like an unsupported type, are not negated.
+//
+// For example:
+//
+//	ensure(1).Not().Equals(2) // Succeeds
+//	ensure(1).Not().Equals(1) // Fails
+//	ensure("abc").Not().MatchesRegexp("^x") // Succeeds
+func (c *Chain) Not() *Chain {
+	c.negated = !c.negated
+	return c
+}
+
+// assertion starts an assertion. It is deferred at the start of each assertion, and describes what the
+// assertion checks, along with the expected value, if any:
+//
+//	defer c.assertion("equal", expected)()
+//
+// When the chain is negated, the returned function fails the test if the assertion did not fail.
+// Assertions can call other assertions, so only the outermost assertion is negated.
+func (c *Chain) assertion(description string, expected ...interface{}) func() {
+	c.assertionDepth++
+
+	return func() {
+		c.assertionDepth--
+		if c.assertionDepth > 0 {
+			return
+		}
+
+		failed, invalid := c.assertionFailed, c.assertionInvalid
+		c.assertionFailed, c.assertionInvalid = false, false
+		if !c.negated || failed || invalid {
+			return
+		}
+
+		c.t.Helper()
+		if len(expected) == 0 {
+			format, args := c.annotate(
+				"\nActual was expected NOT to %s\n\nACTUAL:\n%s",
+				[]interface{}{description, prettyFormat(c.actual)},
+			)
+			c.t.Fatalf(format, args...)
+			return
+		}
+
+		format, args := c.annotate(
+			"\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s",
+			[]interface{}{description, formatNegatedExpected(expected[0]), prettyFormat(c.actual)},
+		)
+		c.t.Fatalf(format, args...)
+	}
+}
+
+// fail fails the assertion. When the chain is negated, the failure is expected, so the test does not fail.
+func (c *Chain) fail(format string, args ...interface{}) {
+	c.t.Helper()
+
+	if c.negated {
+		c.assertionFailed = true
+		return
+	}
+
+	format, args = c.annotate(format, args)
+	c.t.Fatalf(format, args...)
+}
+
+// invalid fails the test when the assertion cannot be checked, for example, when the actual value has an
+// unsupported type. It fails the test even when the chain is negated.
+func (c *Chain) invalid(format string, args ...interface{}) {
+	c.t.Helper()
+	c.assertionInvalid = true
+	format, args = c.annotate(format, args)
+	c.t.Fatalf(format, args...)
+}
+
+func formatNegatedExpected(expected interface{}) string {
+	switch v := expected.(type) {
+	case error:
+		return indent + buildExpectedErrorOutput(v)
+	case []error:
+		formatted := make([]string, 0, len(v))
+		for _, err := range v {
+			formatted = append(formatted, indent+buildExpectedErrorOutput(err))
+		}
+
+		return strings.Join(formatted, "\n")
+	default:
+		return prettyFormat(expected)
+	}
+}
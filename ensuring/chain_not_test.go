@@ -0,0 +1,115 @@
+package ensuring_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainNot(t *testing.T) {
+	t.Run("when the assertion fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(1).Not().Equals(2)
+	})
+
+	t.Run("when the assertion succeeds", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s", "equal", "  int(1)", "  int(1)").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1).Not().Equals(1)
+	})
+
+	t.Run("when the assertion succeeds and has no expected value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s\n\nACTUAL:\n%s", "be empty", "  []string{}").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]string{}).Not().IsEmpty()
+	})
+
+	t.Run("when negated twice", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got false, expected true").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(false).Not().Not().IsTrue()
+	})
+
+	t.Run("when usage is invalid", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected boolean", 1).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1).Not().IsTrue()
+	})
+
+	t.Run("when the chain is used for multiple assertions", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s", "contain", `  "b"`, `  "abc"`).After(
+			mockT.EXPECT().Helper().Times(4),
+		)
+
+		ensure := ensure.New(mockT)
+		chain := ensure("abc").Not()
+		chain.Contains("z")
+		chain.Contains("b")
+	})
+
+	t.Run("when the error matches", func(t *testing.T) {
+		err := errors.New("my error")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s", "be error", "  my error", gomock.Any()).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(fmt.Errorf("wrapped: %w", err)).Not().IsError(err)
+	})
+
+	t.Run("when a nested assertion succeeds", func(t *testing.T) {
+		err := errors.New("my error")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s", "match all errors", "  my error", gomock.Any()).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err).Not().MatchesAllErrors(err)
+	})
+
+	t.Run("when the matcher matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s\n\nACTUAL:\n%s", `match has prefix "a"`, `  "abc"`).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Not().Matches(ensuring.HasPrefix("a"))
+	})
+
+	t.Run("when the regular expression does not match", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Not().MatchesRegexp("^x")
+	})
+}
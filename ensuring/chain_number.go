@@ -0,0 +1,228 @@
+package ensuring
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+// IsGreaterThan ensures the actual number is greater than the expected number.
+// Any integer, unsigned integer, or float kinds can be compared, except signed with unsigned integers.
+//
+// For example:
+//
+//	ensure(len(users)).IsGreaterThan(0)
+func (c *Chain) IsGreaterThan(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be greater than", expected)()
+
+	c.checkOrder(expected, "greater than", func(result int) bool { return result > 0 })
+}
+
+// IsGreaterOrEqual ensures the actual number is greater than or equal to the expected number.
+// Any integer, unsigned integer, or float kinds can be compared, except signed with unsigned integers.
+//
+// For example:
+//
+//	ensure(balance).IsGreaterOrEqual(0)
+func (c *Chain) IsGreaterOrEqual(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be greater than or equal to", expected)()
+
+	c.checkOrder(expected, "greater than or equal to", func(result int) bool { return result >= 0 })
+}
+
+// IsLessThan ensures the actual number is less than the expected number.
+// Any integer, unsigned integer, or float kinds can be compared, except signed with unsigned integers.
+//
+// For example:
+//
+//	ensure(latency).IsLessThan(100 * time.Millisecond)
+func (c *Chain) IsLessThan(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be less than", expected)()
+
+	c.checkOrder(expected, "less than", func(result int) bool { return result < 0 })
+}
+
+// IsLessOrEqual ensures the actual number is less than or equal to the expected number.
+// Any integer, unsigned integer, or float kinds can be compared, except signed with unsigned integers.
+//
+// For example:
+//
+//	ensure(retries).IsLessOrEqual(3)
+func (c *Chain) IsLessOrEqual(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be less than or equal to", expected)()
+
+	c.checkOrder(expected, "less than or equal to", func(result int) bool { return result <= 0 })
+}
+
+// IsInRange ensures the actual number is between lo and hi, inclusive.
+// Any integer, unsigned integer, or float kinds can be compared, except signed with unsigned integers.
+//
+// For example:
+//
+//	ensure(percent).IsInRange(0, 100)
+func (c *Chain) IsInRange(lo, hi interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be in range", fmt.Sprintf("[%v, %v]", lo, hi))()
+
+	rangeResult, rangeOrdered, err := compareNumbers(lo, hi, "Lo", "Hi")
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	if !rangeOrdered || rangeResult > 0 {
+		c.invalid("Lo must not be greater than hi, got: [%v, %v]", lo, hi)
+		return
+	}
+
+	loResult, loOrdered, err := compareNumbers(c.actual, lo, "Actual", "Lo")
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	hiResult, hiOrdered, err := compareNumbers(c.actual, hi, "Actual", "Hi")
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	if !loOrdered || !hiOrdered || loResult < 0 || hiResult > 0 {
+		c.fail("Got %v, expected in range [%v, %v]", c.actual, lo, hi)
+	}
+}
+
+// IsNaN ensures the actual value is a float that is NaN.
+//
+// For example:
+//
+//	ensure(math.Sqrt(-1)).IsNaN()
+func (c *Chain) IsNaN() {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be NaN")()
+
+	reflectValue := reflect.ValueOf(c.actual)
+	if kind := reflectValue.Kind(); kind != reflect.Float32 && kind != reflect.Float64 {
+		c.invalid("Got type %T, expected float32 or float64", c.actual)
+		return
+	}
+
+	if !math.IsNaN(reflectValue.Float()) {
+		c.fail("Got %v, expected NaN", c.actual)
+	}
+}
+
+// EqualsApprox ensures the actual value equals the expected value, except floats only need to be within epsilon
+// of each other. Floats are compared the same way at any depth, including in slices and struct fields.
+// Otherwise, values are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(0.1 + 0.2).EqualsApprox(0.3, 1e-9)
+//	ensure([]float64{1.001, 2}).EqualsApprox([]float64{1, 2}, 0.01)
+func (c *Chain) EqualsApprox(expected interface{}, epsilon float64) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion(fmt.Sprintf("approximately equal (epsilon: %v)", epsilon), expected)()
+
+	if !(epsilon >= 0) {
+		c.invalid("Epsilon must not be negative, got: %v", epsilon)
+		return
+	}
+
+	differences := diff.Compare(c.actual, expected, diff.Options{ApproxFloats: true, FloatEpsilon: epsilon})
+	if len(differences) > 0 {
+		c.fail(
+			"\nActual does not approximately equal expected (epsilon: %v):%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			epsilon,
+			formatDifferences(differences),
+			prettyFormat(c.actual),
+			prettyFormat(expected),
+		)
+	}
+}
+
+func (c *Chain) checkOrder(expected interface{}, description string, isValid func(result int) bool) {
+	c.t.Helper()
+
+	result, ordered, err := compareNumbers(c.actual, expected, "Actual", "Expected")
+	if err != nil {
+		c.invalid(err.Error())
+		return
+	}
+
+	if !ordered || !isValid(result) {
+		c.fail("Got %v, expected %s %v", c.actual, description, expected)
+	}
+}
+
+type numberKind int
+
+const (
+	notNumber numberKind = iota
+	signedNumber
+	unsignedNumber
+	floatNumber
+)
+
+func numberKindOf(value reflect.Value) numberKind {
+	//nolint:exhaustive // Only numeric kinds are supported
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return signedNumber
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedNumber
+	case reflect.Float32, reflect.Float64:
+		return floatNumber
+	default:
+		return notNumber
+	}
+}
+
+// compareNumbers returns -1, 0, or 1 when a is less than, equal to, or greater than b.
+// If either value is NaN, the values are not ordered.
+// Integers are converted to floats when compared with floats, but signed and unsigned integers cannot be compared.
+func compareNumbers(a, b interface{}, aName, bName string) (result int, ordered bool, err error) {
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	aKind, bKind := numberKindOf(aValue), numberKindOf(bValue)
+
+	switch {
+	case aKind == notNumber:
+		//lint:ignore ST1005 Only used internally
+		return 0, false, fmt.Errorf("%s has type %T, expected a number", aName, a) //nolint:err113 // Only used internally
+	case bKind == notNumber:
+		//lint:ignore ST1005 Only used internally
+		return 0, false, fmt.Errorf("%s has type %T, expected a number", bName, b) //nolint:err113 // Only used internally
+	case aKind == signedNumber && bKind == signedNumber:
+		return cmp.Compare(aValue.Int(), bValue.Int()), true, nil
+	case aKind == unsignedNumber && bKind == unsignedNumber:
+		return cmp.Compare(aValue.Uint(), bValue.Uint()), true, nil
+	case aKind == floatNumber || bKind == floatNumber:
+		aFloat, _ := floatOf(a)
+		bFloat, _ := floatOf(b)
+		if math.IsNaN(aFloat) || math.IsNaN(bFloat) {
+			return 0, false, nil
+		}
+
+		return cmp.Compare(aFloat, bFloat), true, nil
+	default:
+		//lint:ignore ST1005 Only used internally
+		return 0, false, fmt.Errorf( //nolint:err113 // Only used internally
+			"Cannot compare signed and unsigned integers: %s has type %T, and %s has type %T",
+			aName, a, bName, b,
+		)
+	}
+}
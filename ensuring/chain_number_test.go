@@ -0,0 +1,316 @@
+package ensuring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/JosiahWitt/ensure"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainIsGreaterThan(t *testing.T) {
+	type Entry struct {
+		Name     string
+		Actual   interface{}
+		Expected interface{}
+		Format   string // Optional
+		Args     []interface{}
+	}
+
+	table := []Entry{
+		{Name: "when greater int", Actual: 2, Expected: 1},
+		{Name: "when greater uint", Actual: uint8(2), Expected: uint64(1)},
+		{Name: "when greater float", Actual: 1.5, Expected: float32(1.25)},
+		{Name: "when greater float than int", Actual: 1.5, Expected: 1},
+		{Name: "when greater int than float", Actual: 2, Expected: 1.5},
+		{Name: "when greater duration", Actual: time.Second, Expected: time.Millisecond},
+		{
+			Name:     "when equal",
+			Actual:   1,
+			Expected: 1,
+			Format:   "Got %v, expected %s %v",
+			Args:     []interface{}{1, "greater than", 1},
+		},
+		{
+			Name:     "when less",
+			Actual:   int64(-5),
+			Expected: int8(3),
+			Format:   "Got %v, expected %s %v",
+			Args:     []interface{}{int64(-5), "greater than", int8(3)},
+		},
+		{
+			Name:     "when NaN",
+			Actual:   math.NaN(),
+			Expected: 1,
+			Format:   "Got %v, expected %s %v",
+			Args:     []interface{}{isNaN(), "greater than", 1},
+		},
+		{
+			Name:     "when signed and unsigned",
+			Actual:   uint(2),
+			Expected: 1,
+			Format:   "Cannot compare signed and unsigned integers: Actual has type uint, and Expected has type int",
+		},
+		{
+			Name:     "when actual is not a number",
+			Actual:   "2",
+			Expected: 1,
+			Format:   "Actual has type string, expected a number",
+		},
+		{
+			Name:     "when expected is not a number",
+			Actual:   2,
+			Expected: nil,
+			Format:   "Expected has type <nil>, expected a number",
+		},
+	}
+
+	for _, entry := range table {
+		t.Run(entry.Name, func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+
+			if entry.Format == "" {
+				mockT.EXPECT().Helper().Times(2)
+			} else {
+				mockT.EXPECT().Fatalf(entry.Format, entry.Args...).After(mockT.EXPECT().Helper().Times(3))
+			}
+
+			ensure := ensure.New(mockT)
+			ensure(entry.Actual).IsGreaterThan(entry.Expected)
+		})
+	}
+}
+
+func TestChainIsGreaterOrEqual(t *testing.T) {
+	t.Run("when equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(uint(1)).IsGreaterOrEqual(uint(1))
+	})
+
+	t.Run("when less", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got %v, expected %s %v", 0.5, "greater than or equal to", 1).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(0.5).IsGreaterOrEqual(1)
+	})
+}
+
+func TestChainIsLessThan(t *testing.T) {
+	t.Run("when less", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(-1).IsLessThan(0)
+	})
+
+	t.Run("when equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got %v, expected %s %v", 1, "less than", 1).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1).IsLessThan(1)
+	})
+
+	t.Run("when negated and less", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s", "be less than", "  int(1)", "  int(0)").After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(0).Not().IsLessThan(1)
+	})
+}
+
+func TestChainIsLessOrEqual(t *testing.T) {
+	t.Run("when equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure(3).IsLessOrEqual(3)
+	})
+
+	t.Run("when greater", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got %v, expected %s %v", 4, "less than or equal to", 3).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(4).IsLessOrEqual(3)
+	})
+}
+
+func TestChainIsInRange(t *testing.T) {
+	type Entry struct {
+		Name   string
+		Actual interface{}
+		Lo     interface{}
+		Hi     interface{}
+		Format string // Optional
+		Args   []interface{}
+	}
+
+	table := []Entry{
+		{Name: "when in range", Actual: 5, Lo: 0, Hi: 10},
+		{Name: "when equal to lo", Actual: 0, Lo: 0, Hi: 10},
+		{Name: "when equal to hi", Actual: 10.0, Lo: 0, Hi: 10},
+		{
+			Name:   "when below lo",
+			Actual: -1,
+			Lo:     0,
+			Hi:     10,
+			Format: "Got %v, expected in range [%v, %v]",
+			Args:   []interface{}{-1, 0, 10},
+		},
+		{
+			Name:   "when above hi",
+			Actual: uint(11),
+			Lo:     uint(0),
+			Hi:     uint(10),
+			Format: "Got %v, expected in range [%v, %v]",
+			Args:   []interface{}{uint(11), uint(0), uint(10)},
+		},
+		{
+			Name:   "when NaN",
+			Actual: math.NaN(),
+			Lo:     0,
+			Hi:     10,
+			Format: "Got %v, expected in range [%v, %v]",
+			Args:   []interface{}{isNaN(), 0, 10},
+		},
+		{
+			Name:   "when lo is greater than hi",
+			Actual: 5,
+			Lo:     10,
+			Hi:     0,
+			Format: "Lo must not be greater than hi, got: [%v, %v]",
+			Args:   []interface{}{10, 0},
+		},
+		{
+			Name:   "when lo is not a number",
+			Actual: 5,
+			Lo:     "0",
+			Hi:     10,
+			Format: "Lo has type string, expected a number",
+		},
+		{
+			Name:   "when actual cannot be compared",
+			Actual: uint(5),
+			Lo:     0,
+			Hi:     10,
+			Format: "Cannot compare signed and unsigned integers: Actual has type uint, and Lo has type int",
+		},
+	}
+
+	for _, entry := range table {
+		t.Run(entry.Name, func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+
+			if entry.Format == "" {
+				mockT.EXPECT().Helper()
+			} else {
+				mockT.EXPECT().Fatalf(entry.Format, entry.Args...).After(mockT.EXPECT().Helper().Times(2))
+			}
+
+			ensure := ensure.New(mockT)
+			ensure(entry.Actual).IsInRange(entry.Lo, entry.Hi)
+		})
+	}
+}
+
+func TestChainIsNaN(t *testing.T) {
+	t.Run("when NaN", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(float32(math.NaN())).IsNaN()
+	})
+
+	t.Run("when not NaN", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got %v, expected NaN", 1.5).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1.5).IsNaN()
+	})
+
+	t.Run("when not a float", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected float32 or float64", 1).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1).IsNaN()
+	})
+}
+
+func TestChainEqualsApprox(t *testing.T) {
+	type Point struct {
+		X, Y float64
+	}
+
+	t.Run("when float is within epsilon", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(0.1+0.2).EqualsApprox(0.3, 1e-9)
+	})
+
+	t.Run("when slice and struct floats are within epsilon", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure([]Point{{X: 1.001, Y: 2}}).EqualsApprox([]Point{{X: 1, Y: 2.009}}, 0.01)
+	})
+
+	t.Run("when floats are not within epsilon", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not approximately equal expected (epsilon: %v):%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			0.01,
+			"\n - [0].Y: 2 != 2.1",
+			"  []ensuring_test.Point{\n      {X:1.001, Y:2},\n  }",
+			"  []ensuring_test.Point{\n      {X:1, Y:2.1},\n  }",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]Point{{X: 1.001, Y: 2}}).EqualsApprox([]Point{{X: 1, Y: 2.1}}, 0.01)
+	})
+
+	t.Run("when epsilon is negative", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Epsilon must not be negative, got: %v", -0.1).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(1.0).EqualsApprox(1.0, -0.1)
+	})
+}
+
+func isNaN() gomock.Matcher {
+	return gomock.Cond(func(x interface{}) bool {
+		f, ok := x.(float64)
+		return ok && math.IsNaN(f)
+	})
+}
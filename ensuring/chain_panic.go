@@ -0,0 +1,168 @@
+package ensuring
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"strings"
+
+	"github.com/kr/text"
+)
+
+// Panics ensures the actual value is a func() that panics when it is called.
+//
+// For example:
+//
+//	ensure(func() { panic("oops") }).Panics() // Succeeds
+//	ensure(func() {}).Panics() // Fails
+func (c *Chain) Panics() {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("panic")()
+
+	fn, ok := c.actual.(func())
+	if !ok {
+		c.invalid("Got type %T, expected func()", c.actual)
+		return
+	}
+
+	if _, _, panicked := recoverPanic(fn); !panicked {
+		c.fail("Expected function to panic, but it did not")
+	}
+}
+
+// PanicsWith ensures the actual value is a func() that panics with the expected value when it is called.
+// If both the recovered value and the expected value are errors, they are compared using errors.Is.
+// Otherwise, they are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(func() { panic("oops") }).PanicsWith("oops") // Succeeds
+//	ensure(func() { panic(fmt.Errorf("wrapped: %w", ErrOops)) }).PanicsWith(ErrOops) // Succeeds
+func (c *Chain) PanicsWith(expected interface{}) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("panic with", expected)()
+
+	fn, ok := c.actual.(func())
+	if !ok {
+		c.invalid("Got type %T, expected func()", c.actual)
+		return
+	}
+
+	value, _, panicked := recoverPanic(fn)
+	if !panicked {
+		c.fail("\nExpected function to panic with:\n%s\n\nBut it did not panic", prettyFormat(expected))
+		return
+	}
+
+	actualErr, isActualErr := value.(error)
+	expectedErr, isExpectedErr := expected.(error)
+	if isActualErr && isExpectedErr {
+		if !errors.Is(actualErr, expectedErr) {
+			c.fail(
+				"\nActual panic error is not the expected error:\n\tActual:   %s\n\tExpected: %s",
+				buildActualErrorOutput(actualErr),
+				buildExpectedErrorOutput(expectedErr),
+			)
+		}
+
+		return
+	}
+
+	if differences := checkEquality(value, expected, nil); len(differences) > 0 {
+		c.fail(
+			"\nActual panic value does not equal expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			formatDifferences(differences),
+			prettyFormat(value),
+			prettyFormat(expected),
+		)
+	}
+}
+
+// PanicsMatching ensures the actual value is a func() that panics with a message matching the regular expression
+// pattern when it is called. The message of a recovered error is its Error() string, and any other value is
+// formatted using fmt.Sprint.
+//
+// For example:
+//
+//	ensure(func() { panic("index 5 out of range") }).PanicsMatching(`index \d+`) // Succeeds
+func (c *Chain) PanicsMatching(pattern string) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("panic matching regular expression", pattern)()
+
+	if pattern == "" {
+		c.invalid("Cannot match against an empty pattern")
+		return
+	}
+
+	fn, ok := c.actual.(func())
+	if !ok {
+		c.invalid("Got type %T, expected func()", c.actual)
+		return
+	}
+
+	patternRegexp, err := regexp.Compile(pattern)
+	if err != nil {
+		c.invalid("Unable to compile regular expression: %s\nERROR: %v", pattern, err)
+		return
+	}
+
+	value, _, panicked := recoverPanic(fn)
+	if !panicked {
+		c.fail("\nExpected function to panic matching regular expression:\n%s\n\nBut it did not panic", prettyFormat(pattern))
+		return
+	}
+
+	message := fmt.Sprint(value)
+	if !patternRegexp.MatchString(message) {
+		c.fail(
+			"Actual panic message does not match regular expression:\n\nACTUAL:\n%s\n\nEXPECTED TO MATCH:\n%s",
+			prettyFormat(message),
+			prettyFormat(pattern),
+		)
+	}
+}
+
+// DoesNotPanic ensures the actual value is a func() that does not panic when it is called.
+// If it panics, the recovered value and stack trace are reported.
+//
+// For example:
+//
+//	ensure(func() {}).DoesNotPanic() // Succeeds
+//	ensure(func() { panic("oops") }).DoesNotPanic() // Fails
+func (c *Chain) DoesNotPanic() {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("not panic")()
+
+	fn, ok := c.actual.(func())
+	if !ok {
+		c.invalid("Got type %T, expected func()", c.actual)
+		return
+	}
+
+	if value, stack, panicked := recoverPanic(fn); panicked {
+		c.fail(
+			"\nExpected function not to panic, but it panicked with:\n%s\n\nSTACK TRACE:\n%s",
+			prettyFormat(value),
+			text.Indent(strings.TrimSuffix(stack, "\n"), indent),
+		)
+	}
+}
+
+// recoverPanic calls fn, and recovers the value and stack trace of any panic.
+// Since Go 1.21, panic(nil) is recovered as a *runtime.PanicNilError, so a nil value is never recovered.
+func recoverPanic(fn func()) (value interface{}, stack string, panicked bool) {
+	defer func() {
+		if value = recover(); value != nil {
+			stack = string(debug.Stack())
+			panicked = true
+		}
+	}()
+
+	fn()
+	return nil, "", false
+}
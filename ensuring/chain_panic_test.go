@@ -0,0 +1,238 @@
+package ensuring_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/ensuring/internal/testhelper"
+	"github.com/JosiahWitt/ensure/internal/mocks/mock_testctx"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainPanics(t *testing.T) {
+	t.Run("when function panics", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic("oops") }).Panics()
+	})
+
+	t.Run("when function panics with nil", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic(nil) }).Panics() //nolint:govet // Testing panic(nil)
+	})
+
+	t.Run("when function does not panic", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Expected function to panic, but it did not").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() {}).Panics()
+	})
+
+	t.Run("when actual is not a function", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected func()", 123).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(123).Panics()
+	})
+
+	t.Run("when used with a real test and a gomock controller", func(t *testing.T) {
+		testhelper.AllowAnyTestContexts(t)
+		ensure := ensure.New(t)
+
+		table := []struct {
+			Name string
+		}{
+			{Name: "first"},
+			{Name: "second"},
+		}
+
+		ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+			mockT := mock_testctx.NewMockT(ensure.GoMockController())
+			mockT.EXPECT().Helper()
+
+			ensure(func() {
+				mockT.Helper()
+				panic("oops")
+			}).Panics()
+		})
+	})
+}
+
+func TestChainPanicsWith(t *testing.T) {
+	errOops := errors.New("oops")
+
+	t.Run("when function panics with expected value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic(ExamplePerson{Name: "John"}) }).PanicsWith(ExamplePerson{Name: "John"})
+	})
+
+	t.Run("when function panics with wrapped expected error", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic(fmt.Errorf("wrapped: %w", errOops)) }).PanicsWith(errOops)
+	})
+
+	t.Run("when function panics with different value", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual panic value does not equal expected:%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"\n - Name: \"John\" != \"Sam\"",
+			ExamplePerson{Name: "John"}.ExpectedOutput(),
+			ExamplePerson{Name: "Sam"}.ExpectedOutput(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic(ExamplePerson{Name: "John"}) }).PanicsWith(ExamplePerson{Name: "Sam"})
+	})
+
+	t.Run("when function panics with different error", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual panic error is not the expected error:\n\tActual:   %s\n\tExpected: %s",
+			"other",
+			"oops",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic(errors.New("other")) }).PanicsWith(errOops)
+	})
+
+	t.Run("when function does not panic", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nExpected function to panic with:\n%s\n\nBut it did not panic", `  "oops"`).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() {}).PanicsWith("oops")
+	})
+
+	t.Run("when actual is not a function", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected func()", "oops").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("oops").PanicsWith("oops")
+	})
+}
+
+func TestChainPanicsMatching(t *testing.T) {
+	t.Run("when panic message matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic("index 5 out of range") }).PanicsMatching(`index \d+`)
+	})
+
+	t.Run("when panic error message matches", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic(errors.New("index 5 out of range")) }).PanicsMatching(`index \d+`)
+	})
+
+	t.Run("when panic message does not match", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Actual panic message does not match regular expression:\n\nACTUAL:\n%s\n\nEXPECTED TO MATCH:\n%s",
+			`  "oops"`,
+			`  "index \\d+"`,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic("oops") }).PanicsMatching(`index \d+`)
+	})
+
+	t.Run("when function does not panic", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\nExpected function to panic matching regular expression:\n%s\n\nBut it did not panic", `  "oops"`).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() {}).PanicsMatching("oops")
+	})
+
+	t.Run("when pattern is empty", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Cannot match against an empty pattern").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() {}).PanicsMatching("")
+	})
+
+	t.Run("when pattern is invalid", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Unable to compile regular expression: %s\nERROR: %v", "(", gomock.Any()).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() {}).PanicsMatching("(")
+	})
+}
+
+func TestChainDoesNotPanic(t *testing.T) {
+	t.Run("when function does not panic", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(func() {}).DoesNotPanic()
+	})
+
+	t.Run("when function panics", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nExpected function not to panic, but it panicked with:\n%s\n\nSTACK TRACE:\n%s",
+			`  "oops"`,
+			ensuring.HasPrefix("  goroutine "),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(func() { panic("oops") }).DoesNotPanic()
+	})
+
+	t.Run("when actual is not a function", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected func()", nil).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(nil).DoesNotPanic()
+	})
+}
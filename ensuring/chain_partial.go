@@ -0,0 +1,76 @@
+package ensuring
+
+import (
+	"strings"
+
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+// PartialOption customizes how [Chain.MatchesPartial] compares values.
+// An option only applies to the MatchesPartial call it is provided to.
+type PartialOption struct {
+	apply func(opts *partialOptions)
+}
+
+type partialOptions struct {
+	showSkippedFields bool
+}
+
+// ShowSkippedFields lists the paths of the actual fields and map keys that were not compared when
+// [Chain.MatchesPartial] fails, so it is clear what was checked.
+func ShowSkippedFields() PartialOption {
+	return PartialOption{
+		apply: func(opts *partialOptions) {
+			opts.showSkippedFields = true
+		},
+	}
+}
+
+// MatchesPartial ensures the actual value matches the parts of the expected value that are provided.
+// Struct fields that are zero in expected are skipped, and map keys that are only in actual are skipped.
+// This applies at any depth, including inside nested structs, slices, and maps.
+// Slices must have the same length, but their elements are compared partially.
+// Everything else is compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(User{ID: 1, Name: "Mary"}).MatchesPartial(User{Name: "Mary"}) // Succeeds
+//	ensure(User{ID: 1, Name: "Mary"}).MatchesPartial(User{Name: "John"}) // Fails
+func (c *Chain) MatchesPartial(expected interface{}, opts ...PartialOption) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("match the provided fields of", expected)()
+
+	partialOpts := partialOptions{}
+	for _, opt := range opts {
+		if opt.apply != nil {
+			opt.apply(&partialOpts)
+		}
+	}
+
+	differences, skipped := diff.ComparePartial(c.actual, expected, diff.Options{})
+	if len(differences) == 0 {
+		return
+	}
+
+	skippedSection := ""
+	if partialOpts.showSkippedFields {
+		skippedSection = "\n\nSKIPPED:\n" + formatSkippedPaths(skipped)
+	}
+
+	c.fail(
+		"\nActual does not match the provided fields of expected:%s%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+		formatDifferences(differences),
+		skippedSection,
+		prettyFormat(c.actual),
+		prettyFormat(expected),
+	)
+}
+
+func formatSkippedPaths(paths []string) string {
+	if len(paths) == 0 {
+		return indent + "(none)"
+	}
+
+	return indent + strings.Join(paths, "\n"+indent)
+}
@@ -0,0 +1,84 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+)
+
+func TestChainMatchesPartial(t *testing.T) {
+	actual := ExamplePerson{
+		Name:     "John",
+		Email:    "john@example.com",
+		Messages: []ExampleMessage{{Body: "Hello"}},
+	}
+
+	t.Run("when provided fields match", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(actual).MatchesPartial(ExamplePerson{Name: "John"})
+	})
+
+	t.Run("when provided fields match inside slices", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(actual).MatchesPartial(ExamplePerson{Messages: []ExampleMessage{{Body: "Hello"}}})
+	})
+
+	t.Run("when provided fields do not match", func(t *testing.T) {
+		expected := ExamplePerson{Name: "Sam", Messages: []ExampleMessage{{Body: "Hi"}}}
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not match the provided fields of expected:%s%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"\n - Name: \"John\" != \"Sam\"\n - Messages[0].Body: \"Hello\" != \"Hi\"",
+			"",
+			actual.ExpectedOutput(),
+			expected.ExpectedOutput(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(actual).MatchesPartial(expected)
+	})
+
+	t.Run("when showing skipped fields", func(t *testing.T) {
+		expected := ExamplePerson{Name: "Sam"}
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not match the provided fields of expected:%s%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"\n - Name: \"John\" != \"Sam\"",
+			"\n\nSKIPPED:\n  Email\n  ssn\n  Messages",
+			actual.ExpectedOutput(),
+			expected.ExpectedOutput(),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(actual).MatchesPartial(expected, ensuring.ShowSkippedFields())
+	})
+
+	t.Run("when showing skipped fields and none were skipped", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not match the provided fields of expected:%s%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"\n - [0]: 1 != 2",
+			"\n\nSKIPPED:\n  (none)",
+			"  []int{1}",
+			"  []int{2}",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure([]int{1}).MatchesPartial([]int{2}, ensuring.ShowSkippedFields())
+	})
+}
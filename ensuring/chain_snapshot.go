@@ -0,0 +1,83 @@
+package ensuring
+
+import (
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/JosiahWitt/ensure/internal/inlinesnapshot"
+	"github.com/kr/pretty"
+)
+
+const (
+	inlineSnapshotFuncName  = "MatchesInlineSnapshot"
+	inlineSnapshotUpdateEnv = "ENSURE_UPDATE_SNAPSHOTS"
+)
+
+//nolint:gochecknoglobals // Shared, so multiple snapshots in the same file can be updated during a test run.
+var inlineSnapshotUpdater = inlinesnapshot.NewUpdater()
+
+// MatchesInlineSnapshot ensures the actual value matches the snapshot.
+// Strings are compared directly, and any other value is pretty-printed before it is compared.
+// Multi-line snapshots can start and end with an extra newline, so they are easier to read.
+//
+// When the ENSURE_UPDATE_SNAPSHOTS environment variable is set to a true value (for example, 1), the snapshot
+// string literal in the calling test file is rewritten with the actual value instead.
+// To support this, MatchesInlineSnapshot must be called directly from the test file with a string literal,
+// and it cannot be called multiple times with different values from the same line (for example, in a table driven test).
+//
+// For example:
+//
+//	ensure(user).MatchesInlineSnapshot(`
+//	&app.User{
+//	    Name:  "Mary",
+//	    Email: "mary@example.com",
+//	}
+//	`)
+func (c *Chain) MatchesInlineSnapshot(snapshot string) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("match inline snapshot", snapshot)()
+
+	actual := formatInlineSnapshot(c.actual)
+	expected := inlinesnapshot.Normalize(snapshot)
+	if actual == expected {
+		return
+	}
+
+	// Negated snapshots are not updated, since the actual value is expected to differ
+	if shouldUpdateInlineSnapshots() && !c.negated {
+		_, callerFilePath, callerLine, ok := runtime.Caller(1)
+		if !ok {
+			c.invalid("Can't get caller from runtime")
+			return
+		}
+
+		if err := inlineSnapshotUpdater.Update(callerFilePath, callerLine, inlineSnapshotFuncName, actual); err != nil {
+			c.invalid("Cannot update inline snapshot: %v", err)
+			return
+		}
+
+		c.t.Logf("Updated inline snapshot: %s:%d", callerFilePath, callerLine)
+		return
+	}
+
+	format, args := formatInequalityMessage(nil, actual, expected)
+	c.fail(
+		"\nActual does not match inline snapshot. To update it, rerun the test with %s=1\n"+format,
+		append([]interface{}{inlineSnapshotUpdateEnv}, args...)...,
+	)
+}
+
+func formatInlineSnapshot(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	return pretty.Sprint(value)
+}
+
+func shouldUpdateInlineSnapshots() bool {
+	update, _ := strconv.ParseBool(os.Getenv(inlineSnapshotUpdateEnv))
+	return update
+}
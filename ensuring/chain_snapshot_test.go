@@ -0,0 +1,94 @@
+package ensuring_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/internal/inlinesnapshot"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChainMatchesInlineSnapshot(t *testing.T) {
+	const errorMessageFormat = "\nActual does not match inline snapshot. To update it, rerun the test with %s=1\n" +
+		"\nActual %s does not equal expected %s:\n\nDIFF (-actual +expected):\n%s"
+
+	t.Run("when string matches snapshot", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure("Hello, world!").MatchesInlineSnapshot(`Hello, world!`)
+	})
+
+	t.Run("when value matches multiline snapshot", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(ExamplePerson{Name: "John", Email: "john@example.com"}).MatchesInlineSnapshot(`
+ensuring_test.ExamplePerson{
+    Name:     "John",
+    Email:    "john@example.com",
+    ssn:      "",
+    Messages: nil,
+}
+`)
+	})
+
+	t.Run("when value does not match snapshot", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(errorMessageFormat,
+			"ENSURE_UPDATE_SNAPSHOTS",
+			"string",
+			"string",
+			"  @@ -1,5 +1,5 @@\n"+
+				"   ensuring_test.ExamplePerson{\n"+
+				"  -    Name:     \"John\",\n"+
+				"  +    Name:     \"Sam\",\n"+
+				"       Email:    \"john@example.com\",\n"+
+				"       ssn:      \"\",\n"+
+				"       Messages: nil,",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(ExamplePerson{Name: "John", Email: "john@example.com"}).MatchesInlineSnapshot(`
+ensuring_test.ExamplePerson{
+    Name:     "Sam",
+    Email:    "john@example.com",
+    ssn:      "",
+    Messages: nil,
+}
+`)
+	})
+
+	t.Run("when updating snapshots", func(t *testing.T) {
+		t.Setenv("ENSURE_UPDATE_SNAPSHOTS", "1")
+
+		t.Run("when value already matches snapshot", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Helper()
+
+			ensure := ensure.New(mockT)
+			ensure("abc").MatchesInlineSnapshot(`abc`)
+		})
+
+		t.Run("when snapshot is not a string literal", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Fatalf("Cannot update inline snapshot: %v", gomock.Any()).Do(func(_ string, args ...interface{}) {
+				if err, _ := args[0].(error); !errors.Is(err, inlinesnapshot.ErrNotStringLiteral) {
+					t.Errorf("expected error to be ErrNotStringLiteral, got: %v", err)
+				}
+			}).After(
+				mockT.EXPECT().Helper().Times(2),
+			)
+
+			snapshot := "xyz"
+
+			ensure := ensure.New(mockT)
+			ensure("abc").MatchesInlineSnapshot(snapshot)
+		})
+	})
+}
@@ -3,8 +3,11 @@ package ensuring_test
 import (
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/internal/diff"
 	"github.com/kr/pretty"
 	"github.com/kr/text"
 	"go.uber.org/mock/gomock"
@@ -23,7 +26,7 @@ func TestChainIsTrue(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got false, expected true").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -35,7 +38,7 @@ func TestChainIsTrue(t *testing.T) {
 
 		const val = "not a boolean"
 		mockT.EXPECT().Fatalf("Got type %T, expected boolean", val).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -56,7 +59,7 @@ func TestChainIsFalse(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got true, expected false").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -68,7 +71,7 @@ func TestChainIsFalse(t *testing.T) {
 
 		const val = "not a boolean"
 		mockT.EXPECT().Fatalf("Got type %T, expected boolean", val).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -92,7 +95,7 @@ func TestChainIsNil(t *testing.T) {
 
 		const val = "not nil"
 		mockT.EXPECT().Fatalf(failureFormat, val).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -117,7 +120,7 @@ func TestChainIsNil(t *testing.T) {
 			ptr := &val
 
 			mockT.EXPECT().Fatalf(failureFormat, ptr).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -142,7 +145,7 @@ func TestChainIsNil(t *testing.T) {
 			slice := []string{}
 
 			mockT.EXPECT().Fatalf(failureFormat, slice).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -167,7 +170,7 @@ func TestChainIsNil(t *testing.T) {
 			m := map[string]string{}
 
 			mockT.EXPECT().Fatalf(failureFormat, m).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -192,7 +195,7 @@ func TestChainIsNil(t *testing.T) {
 			f := func(s string) string { return "hello, " + s }
 
 			mockT.EXPECT().Fatalf(failureFormat, gomock.Any()).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -217,7 +220,7 @@ func TestChainIsNil(t *testing.T) {
 			c := make(chan string)
 
 			mockT.EXPECT().Fatalf(failureFormat, c).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -242,7 +245,7 @@ func TestChainIsNil(t *testing.T) {
 			var iface interface{ Hello(string) string } = &ExampleGreeter{}
 
 			mockT.EXPECT().Fatalf(failureFormat, iface).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 
 			ensure := ensure.New(mockT)
@@ -265,7 +268,7 @@ func TestChainIsNotNil(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got nil of type %T, expected it not to be nil", nil).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -278,7 +281,7 @@ func TestChainIsNotNil(t *testing.T) {
 		var nilPtr *string
 
 		mockT.EXPECT().Fatalf("Got nil of type %T, expected it not to be nil", nilPtr).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -326,11 +329,11 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when nil map equals empty map", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - <nil map> != map[]",
+			"Actual does not equal expected:\n - map[string]string(nil) != map[string]string{}",
 			"  map[string]string{}",
 			"  map[string]string{}",
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		var nilMap map[string]string
@@ -342,11 +345,11 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when nil slice equals empty slice", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - <nil slice> != []",
+			"Actual does not equal expected:\n - []string(nil) != []string{}",
 			"  []string(nil)",
 			"  []string{}",
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		var nilSlice []string
@@ -368,11 +371,11 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when one field is not equal", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - Name: John != Sam",
+			"Actual does not equal expected:\n - Name: \"John\" != \"Sam\"",
 			ExamplePerson{Name: "John", Email: "john@test"}.ExpectedOutput(),
 			ExamplePerson{Name: "Sam", Email: "john@test"}.ExpectedOutput(),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -382,11 +385,11 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when not equal: expected is nil", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - {John john@test  []} != <nil pointer>",
+			"Actual does not equal expected:\n - ensuring_test.ExamplePerson{...} != nil",
 			ExamplePerson{Name: "John", Email: "john@test"}.ExpectedOutput(),
 			"  nil",
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -396,11 +399,11 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when not equal: actual is nil", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - <nil pointer> != {John john@test  []}",
+			"Actual does not equal expected:\n - nil != ensuring_test.ExamplePerson{...}",
 			"  nil",
 			ExamplePerson{Name: "John", Email: "john@test"}.ExpectedOutput(),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -410,11 +413,11 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when unexported field is not equal", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - ssn: 123456789 != 123456780",
+			"Actual does not equal expected:\n - ssn: \"123456789\" != \"123456780\"",
 			ExamplePerson{Name: "John", Email: "john@test", ssn: "123456789"}.ExpectedOutput(),
 			ExamplePerson{Name: "John", Email: "john@test", ssn: "123456780"}.ExpectedOutput(),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -424,7 +427,7 @@ func TestChainEquals(t *testing.T) {
 	t.Run("when two fields are not equal", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 		mockT.EXPECT().Fatalf(errorMessageFormat,
-			"Actual does not equal expected:\n - Name: John != Sam\n - Messages.slice[1].Body: Hello != Greetings",
+			"Actual does not equal expected:\n - Name: \"John\" != \"Sam\"\n - Messages[1].Body: \"Hello\" != \"Greetings\"",
 			ExamplePerson{
 				Name:  "John",
 				Email: "john@test",
@@ -442,7 +445,7 @@ func TestChainEquals(t *testing.T) {
 				},
 			}.ExpectedOutput(),
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -464,6 +467,116 @@ func TestChainEquals(t *testing.T) {
 			})
 	})
 
+	t.Run("options", func(t *testing.T) {
+		t.Run("when nil slice equals empty slice with NilEqualsEmpty", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Helper()
+
+			var nilSlice []string
+
+			ensure := ensure.New(mockT)
+			ensure(nilSlice).Equals([]string{}, ensuring.NilEqualsEmpty())
+		})
+
+		t.Run("when ignored field is not equal with IgnoreFields", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Helper()
+
+			ensure := ensure.New(mockT)
+			ensure(ExamplePerson{Name: "John", Email: "john@test"}).
+				Equals(ExamplePerson{Name: "John", Email: "sam@test"}, ensuring.IgnoreFields("Email"))
+		})
+
+		t.Run("when unexported field is not equal with IgnoreUnexported", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Helper()
+
+			ensure := ensure.New(mockT)
+			ensure(ExamplePerson{Name: "John", ssn: "123456789"}).
+				Equals(ExamplePerson{Name: "John", ssn: "123456780"}, ensuring.IgnoreUnexported())
+		})
+
+		t.Run("when unexported times are in different locations with CompareTimesWithEqual", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Helper()
+
+			type event struct {
+				at time.Time
+			}
+
+			now := time.Now()
+
+			ensure := ensure.New(mockT)
+			ensure(event{at: now}).Equals(event{at: now.UTC()}, ensuring.CompareTimesWithEqual())
+		})
+
+		t.Run("when funcs and channels are different", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Helper()
+
+			type handler struct {
+				Done     chan struct{}
+				OnChange func()
+			}
+
+			ensure := ensure.New(mockT)
+			ensure(handler{Done: make(chan struct{}), OnChange: func() {}}).Equals(handler{})
+		})
+
+		t.Run("when funcs are different with CompareReferences", func(t *testing.T) {
+			onChange := func() {}
+
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Fatalf(errorMessageFormat,
+				"Actual does not equal expected:\n - OnChange: "+diff.FormatValue(onChange)+" != func()(nil)",
+				gomock.Any(),
+				gomock.Any(),
+			).After(
+				mockT.EXPECT().Helper().Times(2),
+			)
+
+			type handler struct {
+				OnChange func()
+			}
+
+			ensure := ensure.New(mockT)
+			ensure(handler{OnChange: onChange}).Equals(handler{}, ensuring.CompareReferences())
+		})
+
+		t.Run("when other fields are not equal with IgnoreFields", func(t *testing.T) {
+			mockT := setupMockTWithCleanupCheck(t)
+			mockT.EXPECT().Fatalf(errorMessageFormat,
+				"Actual does not equal expected:\n - Name: \"John\" != \"Sam\"",
+				ExamplePerson{Name: "John", Email: "john@test"}.ExpectedOutput(),
+				ExamplePerson{Name: "Sam", Email: "sam@test"}.ExpectedOutput(),
+			).After(
+				mockT.EXPECT().Helper().Times(2),
+			)
+
+			ensure := ensure.New(mockT)
+			ensure(ExamplePerson{Name: "John", Email: "john@test"}).
+				Equals(ExamplePerson{Name: "Sam", Email: "sam@test"}, ensuring.IgnoreFields("Email"))
+		})
+
+		t.Run("options only apply to their call", func(t *testing.T) {
+			mockT := setupMockT(t)
+			mockT.EXPECT().Helper().AnyTimes()
+			mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).Times(2)
+
+			var nilSlice []string
+
+			ensure := ensure.New(mockT)
+			ensure(nilSlice).Equals([]string{}, ensuring.NilEqualsEmpty())
+
+			mockT.EXPECT().Fatalf(errorMessageFormat,
+				"Actual does not equal expected:\n - []string(nil) != []string{}",
+				"  []string(nil)",
+				"  []string{}",
+			)
+			ensure(nilSlice).Equals([]string{})
+		})
+	})
+
 	t.Run("when concurrent", func(t *testing.T) {
 		mockT := setupMockT(t)
 
@@ -499,6 +612,7 @@ func TestChainEquals(t *testing.T) {
 		const (
 			differentTypesErrorFormat = "\nTypes provided to Equals are different: got %s, expected %s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s"
 			inequalStringErrorFormat  = "\nActual %s does not equal expected %s:\n\nACTUAL:\n%s\n\nEXPECTED:\n%s"
+			multilineErrorFormat      = "\nActual %s does not equal expected %s:\n\nDIFF (-actual +expected):\n%s"
 		)
 
 		t.Run("strings", func(t *testing.T) {
@@ -516,7 +630,7 @@ func TestChainEquals(t *testing.T) {
 					`  "abc"`,
 					"  (empty string)",
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
@@ -529,37 +643,70 @@ func TestChainEquals(t *testing.T) {
 					"  (empty string)",
 					`  "abc"`,
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
 				ensure("").Equals("abc")
 			})
 
-			t.Run("when strings not equal: expected contains double quotes, newlines, and tabs", func(t *testing.T) {
+			t.Run("when strings not equal: expected contains double quotes and tabs", func(t *testing.T) {
 				mockT := setupMockTWithCleanupCheck(t)
 				mockT.EXPECT().Fatalf(inequalStringErrorFormat, "string", "string",
-					`  "abc\n\"xyz\"\n\tqwerty"`, // Formatted with quotes and escaped control characters
+					`  "abc \"xyz\"\tqwerty"`, // Formatted with quotes and escaped control characters
 					`  "abc"`,
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
-				ensure("abc\n\"xyz\"\n\tqwerty").Equals("abc")
+				ensure("abc \"xyz\"\tqwerty").Equals("abc")
 			})
 
-			t.Run("when strings not equal: actual contains double quotes, newlines, and tabs", func(t *testing.T) {
+			t.Run("when strings not equal: actual contains double quotes and tabs", func(t *testing.T) {
 				mockT := setupMockTWithCleanupCheck(t)
 				mockT.EXPECT().Fatalf(inequalStringErrorFormat, "string", "string",
 					`  "abc"`,
-					`  "abc\n\"xyz\"\n\tqwerty"`, // Formatted with quotes and escaped control characters
+					`  "abc \"xyz\"\tqwerty"`, // Formatted with quotes and escaped control characters
+				).After(
+					mockT.EXPECT().Helper().Times(2),
+				)
+
+				ensure := ensure.New(mockT)
+				ensure("abc").Equals("abc \"xyz\"\tqwerty")
+			})
+
+			t.Run("when multiline strings not equal", func(t *testing.T) {
+				mockT := setupMockTWithCleanupCheck(t)
+				mockT.EXPECT().Fatalf(multilineErrorFormat, "string", "string",
+					"  @@ -1,3 +1,3 @@\n"+
+						"   SELECT *\n"+
+						"  -FROM users\n"+
+						"  +FROM accounts\n"+
+						"   WHERE id = 1",
+				).After(
+					mockT.EXPECT().Helper().Times(2),
+				)
+
+				ensure := ensure.New(mockT)
+				ensure("SELECT *\nFROM users\nWHERE id = 1").Equals("SELECT *\nFROM accounts\nWHERE id = 1")
+			})
+
+			t.Run("when single line string does not equal multiline string", func(t *testing.T) {
+				mockT := setupMockTWithCleanupCheck(t)
+				mockT.EXPECT().Fatalf(multilineErrorFormat, "string", "string",
+					"  @@ -1 +1,2 @@\n"+
+						"  -abc\n"+
+						"  \\ No newline at end of file\n"+
+						"  +abc\n"+
+						"  +xyz\n"+
+						"  \\ No newline at end of file",
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
-				ensure("abc").Equals("abc\n\"xyz\"\n\tqwerty")
+				ensure("abc").Equals("abc\nxyz")
 			})
 		})
 
@@ -574,11 +721,11 @@ func TestChainEquals(t *testing.T) {
 
 			t.Run("when non-string byte slices are not equal", func(t *testing.T) {
 				mockT := setupMockTWithCleanupCheck(t)
-				mockT.EXPECT().Fatalf(errorMessageFormat, "Actual does not equal expected:\n - slice[2]: 128 != 129",
+				mockT.EXPECT().Fatalf(errorMessageFormat, "Actual does not equal expected:\n - [2]: 128 != 129",
 					"  []uint8{0x1, 0x2, 0x80}",
 					"  []uint8{0x1, 0x2, 0x81}",
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
@@ -599,7 +746,7 @@ func TestChainEquals(t *testing.T) {
 					`  []byte("abc")`,
 					"  (empty []byte)",
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
@@ -612,37 +759,52 @@ func TestChainEquals(t *testing.T) {
 					"  (empty []byte)",
 					`  []byte("abc")`,
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
 				ensure([]byte("")).Equals([]byte("abc"))
 			})
 
-			t.Run("when string byte slices not equal: expected contains double quotes, newlines, and tabs", func(t *testing.T) {
+			t.Run("when string byte slices not equal: expected contains double quotes and tabs", func(t *testing.T) {
 				mockT := setupMockTWithCleanupCheck(t)
 				mockT.EXPECT().Fatalf(inequalStringErrorFormat, "[]byte", "[]byte",
-					`  []byte("abc\n\"xyz\"\n\tqwerty")`, // Formatted with quotes and escaped control characters
+					`  []byte("abc \"xyz\"\tqwerty")`, // Formatted with quotes and escaped control characters
 					`  []byte("abc")`,
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
-				ensure([]byte("abc\n\"xyz\"\n\tqwerty")).Equals([]byte("abc"))
+				ensure([]byte("abc \"xyz\"\tqwerty")).Equals([]byte("abc"))
 			})
 
-			t.Run("when string byte slices not equal: actual contains double quotes, newlines, and tabs", func(t *testing.T) {
+			t.Run("when string byte slices not equal: actual contains double quotes and tabs", func(t *testing.T) {
 				mockT := setupMockTWithCleanupCheck(t)
 				mockT.EXPECT().Fatalf(inequalStringErrorFormat, "[]byte", "[]byte",
 					`  []byte("abc")`,
-					`  []byte("abc\n\"xyz\"\n\tqwerty")`, // Formatted with quotes and escaped control characters
+					`  []byte("abc \"xyz\"\tqwerty")`, // Formatted with quotes and escaped control characters
+				).After(
+					mockT.EXPECT().Helper().Times(2),
+				)
+
+				ensure := ensure.New(mockT)
+				ensure([]byte("abc")).Equals([]byte("abc \"xyz\"\tqwerty"))
+			})
+
+			t.Run("when multiline string byte slices not equal", func(t *testing.T) {
+				mockT := setupMockTWithCleanupCheck(t)
+				mockT.EXPECT().Fatalf(multilineErrorFormat, "[]byte", "[]byte",
+					"  @@ -1,2 +1,2 @@\n"+
+						"   line 1\n"+
+						"  -line 2\n"+
+						"  +line two",
 				).After(
-					mockT.EXPECT().Helper(),
+					mockT.EXPECT().Helper().Times(2),
 				)
 
 				ensure := ensure.New(mockT)
-				ensure([]byte("abc")).Equals([]byte("abc\n\"xyz\"\n\tqwerty"))
+				ensure([]byte("line 1\nline 2\n")).Equals([]byte("line 1\nline two\n"))
 			})
 		})
 
@@ -654,7 +816,7 @@ func TestChainEquals(t *testing.T) {
 						"  (empty []byte)",
 						"  (empty string)",
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -667,7 +829,7 @@ func TestChainEquals(t *testing.T) {
 						"  (empty []byte)",
 						`  "Hello, World!"`,
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -680,7 +842,7 @@ func TestChainEquals(t *testing.T) {
 						`  []byte("Hello, World!")`,
 						"  (empty string)",
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -693,7 +855,7 @@ func TestChainEquals(t *testing.T) {
 						`  []byte("Hello, World!")`,
 						`  "Hello, World!"`,
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -706,7 +868,7 @@ func TestChainEquals(t *testing.T) {
 						`  []byte("Hello")`,
 						`  "World"`,
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -721,7 +883,7 @@ func TestChainEquals(t *testing.T) {
 						"  (empty string)",
 						"  (empty []byte)",
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -734,7 +896,7 @@ func TestChainEquals(t *testing.T) {
 						"  (empty string)",
 						`  []byte("Hello, World!")`,
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -747,7 +909,7 @@ func TestChainEquals(t *testing.T) {
 						`  "Hello, World!"`,
 						"  (empty []byte)",
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -760,7 +922,7 @@ func TestChainEquals(t *testing.T) {
 						`  "Hello, World!"`,
 						`  []byte("Hello, World!")`,
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -773,7 +935,7 @@ func TestChainEquals(t *testing.T) {
 						`  "Hello"`,
 						`  []byte("World")`,
 					).After(
-						mockT.EXPECT().Helper(),
+						mockT.EXPECT().Helper().Times(2),
 					)
 
 					ensure := ensure.New(mockT)
@@ -792,7 +954,7 @@ func TestChainIsEmpty(t *testing.T) {
 			mockT.EXPECT().Helper()
 		} else {
 			mockT.EXPECT().Fatalf("Got %+v with length %d, expected it to be empty", value, valueLength).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 		}
 
@@ -804,7 +966,7 @@ func TestChainIsEmpty(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got type int, expected array, slice, string, or map").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -818,7 +980,7 @@ func TestChainIsNotEmpty(t *testing.T) {
 
 		if valueLength == 0 {
 			mockT.EXPECT().Fatalf("Got %+v, expected it to not be empty", value).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 		} else {
 			mockT.EXPECT().Helper()
@@ -832,7 +994,7 @@ func TestChainIsNotEmpty(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got type int, expected array, slice, string, or map").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -903,7 +1065,7 @@ func TestChainContains(t *testing.T) {
 			mockT.EXPECT().Helper()
 		} else {
 			mockT.EXPECT().Fatalf("Actual does not contain expected:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN:\n%s", formattedActual, formattedExpected).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 		}
 
@@ -915,23 +1077,53 @@ func TestChainContains(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got type int, expected string, array, or slice").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
 		ensure(1234).Contains(2)
 	})
 
+	t.Run("when contains: same channel", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ch := make(chan int)
+
+		ensure := ensure.New(mockT)
+		ensure([]chan int{make(chan int), ch}).Contains(ch)
+	})
+
 	t.Run("when string is expected to contain a non-string type", func(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got string, but expected is a int, and a string can only contain other strings").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
 		ensure("hello").DoesNotContain(123)
 	})
+
+	t.Run("when does not contain: slice of structs", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+
+		actual := []ExamplePerson{{Name: "John", Email: "john@example.com"}, {Name: "Sam", Email: "sam@example.com"}}
+		expected := ExamplePerson{Name: "Sam", Email: "sam@example.org"}
+
+		mockT.EXPECT().Fatalf(
+			"Actual does not contain expected:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN:\n%s\n\nCLOSEST ELEMENT [%d] DIFFERS FROM EXPECTED:%s",
+			gomock.Any(),
+			gomock.Any(),
+			1,
+			"\n - Email: \"sam@example.com\" != \"sam@example.org\"",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(actual).Contains(expected)
+	})
 }
 
 func TestChainDoesNotContain(t *testing.T) {
@@ -940,7 +1132,7 @@ func TestChainDoesNotContain(t *testing.T) {
 
 		if doesContain {
 			mockT.EXPECT().Fatalf("Actual contains expected, but did not expect it to:\n\nACTUAL:\n%s\n\nEXPECTED NOT TO CONTAIN:\n%s", formattedActual, formattedExpected).After(
-				mockT.EXPECT().Helper(),
+				mockT.EXPECT().Helper().Times(2),
 			)
 		} else {
 			mockT.EXPECT().Helper()
@@ -954,7 +1146,7 @@ func TestChainDoesNotContain(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got type int, expected string, array, or slice").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -965,7 +1157,7 @@ func TestChainDoesNotContain(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Got string, but expected is a int, and a string can only contain other strings").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -1071,7 +1263,7 @@ func TestChainMatchesRegexp(t *testing.T) {
 			`  "hello 1-3 world"`,      // Indented
 			`  "^hello [1-3]+ world$"`, // Indented
 		).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -1082,7 +1274,7 @@ func TestChainMatchesRegexp(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Cannot match against an empty pattern").After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -1093,7 +1285,7 @@ func TestChainMatchesRegexp(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Actual is not a string, it's a %T", 123).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
@@ -1104,7 +1296,7 @@ func TestChainMatchesRegexp(t *testing.T) {
 		mockT := setupMockTWithCleanupCheck(t)
 
 		mockT.EXPECT().Fatalf("Unable to compile regular expression: %s\nERROR: %v", "[", gomock.Any()).After(
-			mockT.EXPECT().Helper(),
+			mockT.EXPECT().Helper().Times(2),
 		)
 
 		ensure := ensure.New(mockT)
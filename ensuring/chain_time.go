@@ -0,0 +1,184 @@
+package ensuring
+
+import (
+	"math"
+	"time"
+)
+
+// IsWithinDuration ensures the actual value is a [time.Time] that is within tolerance of the expected time.
+// Times are compared by their instant, so monotonic clock readings and locations are ignored.
+//
+// For example:
+//
+//	ensure(user.CreatedAt).IsWithinDuration(time.Now(), time.Second)
+func (c *Chain) IsWithinDuration(expected time.Time, tolerance time.Duration) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be within "+tolerance.String()+" of", formatTime(expected))()
+
+	if tolerance < 0 {
+		c.invalid("Tolerance must not be negative, got: %s", tolerance)
+		return
+	}
+
+	actual, ok := c.actual.(time.Time)
+	if !ok {
+		c.invalid("Got type %T, expected time.Time", c.actual)
+		return
+	}
+
+	// Comparing against the bounds avoids overflowing, since Sub saturates for times that are far apart
+	if actual.Before(expected.Add(-tolerance)) || actual.After(expected.Add(tolerance)) {
+		c.fail(
+			"\nActual time is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			tolerance,
+			formatTime(actual),
+			formatTime(expected),
+			actual.Sub(expected),
+		)
+	}
+}
+
+// IsBefore ensures the actual value is a [time.Time] that is before the expected time.
+//
+// For example:
+//
+//	ensure(order.ShippedAt).IsBefore(order.DeliveredAt)
+func (c *Chain) IsBefore(expected time.Time) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be before", formatTime(expected))()
+
+	actual, ok := c.actual.(time.Time)
+	if !ok {
+		c.invalid("Got type %T, expected time.Time", c.actual)
+		return
+	}
+
+	if !actual.Before(expected) {
+		c.fail(
+			"\nActual time is not before expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			formatTime(actual),
+			formatTime(expected),
+			actual.Sub(expected),
+		)
+	}
+}
+
+// IsAfter ensures the actual value is a [time.Time] that is after the expected time.
+//
+// For example:
+//
+//	ensure(order.DeliveredAt).IsAfter(order.ShippedAt)
+func (c *Chain) IsAfter(expected time.Time) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be after", formatTime(expected))()
+
+	actual, ok := c.actual.(time.Time)
+	if !ok {
+		c.invalid("Got type %T, expected time.Time", c.actual)
+		return
+	}
+
+	if !actual.After(expected) {
+		c.fail(
+			"\nActual time is not after expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			formatTime(actual),
+			formatTime(expected),
+			actual.Sub(expected),
+		)
+	}
+}
+
+// IsBetween ensures the actual value is a [time.Time] that is between start and end, inclusive.
+//
+// For example:
+//
+//	ensure(event.At).IsBetween(start, end)
+func (c *Chain) IsBetween(start, end time.Time) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be between", formatTime(start)+" and "+formatTime(end))()
+
+	if end.Before(start) {
+		c.invalid("End must not be before start:\n\tStart: %s\n\tEnd:   %s", formatTime(start), formatTime(end))
+		return
+	}
+
+	actual, ok := c.actual.(time.Time)
+	if !ok {
+		c.invalid("Got type %T, expected time.Time", c.actual)
+		return
+	}
+
+	var difference string
+	switch {
+	case actual.Before(start):
+		difference = start.Sub(actual).String() + " before start"
+	case actual.After(end):
+		difference = actual.Sub(end).String() + " after end"
+	default:
+		return
+	}
+
+	c.fail(
+		"\nActual time is not between start and end:\n\tActual:     %s\n\tStart:      %s\n\tEnd:        %s\n\tDifference: %s",
+		formatTime(actual),
+		formatTime(start),
+		formatTime(end),
+		difference,
+	)
+}
+
+// IsDurationWithin ensures the actual value is a [time.Duration] that is within tolerance of the expected duration.
+//
+// For example:
+//
+//	ensure(elapsed).IsDurationWithin(time.Second, 100*time.Millisecond)
+func (c *Chain) IsDurationWithin(expected, tolerance time.Duration) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be within "+tolerance.String()+" of", expected.String())()
+
+	if tolerance < 0 {
+		c.invalid("Tolerance must not be negative, got: %s", tolerance)
+		return
+	}
+
+	actual, ok := c.actual.(time.Duration)
+	if !ok {
+		c.invalid("Got type %T, expected time.Duration", c.actual)
+		return
+	}
+
+	if actual < subDurations(expected, tolerance) || actual > subDurations(expected, -tolerance) {
+		c.fail(
+			"\nActual duration is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			tolerance,
+			actual,
+			expected,
+			subDurations(actual, expected),
+		)
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+// subDurations returns a-b, saturating at the minimum or maximum duration if it overflows, like [time.Time.Sub].
+func subDurations(a, b time.Duration) time.Duration {
+	difference := a - b
+
+	// It can only overflow if the signs are different, in which case the result must have the same sign as a
+	if (a < 0) != (b < 0) && (difference < 0) != (a < 0) {
+		if a < 0 {
+			return math.MinInt64
+		}
+
+		return math.MaxInt64
+	}
+
+	return difference
+}
@@ -0,0 +1,358 @@
+package ensuring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/JosiahWitt/ensure"
+)
+
+func TestChainIsWithinDuration(t *testing.T) {
+	exampleTime := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	t.Run("when within tolerance", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime.Add(-time.Second)).IsWithinDuration(exampleTime, time.Second)
+	})
+
+	t.Run("when in a different location with a monotonic clock reading", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		now := time.Now()
+
+		ensure := ensure.New(mockT)
+		ensure(now).IsWithinDuration(now.Round(0).In(time.FixedZone("UTC-1", -60*60)), 0)
+	})
+
+	t.Run("when not within tolerance", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			time.Second,
+			"2020-01-02T03:04:07.000000006Z",
+			"2020-01-02T03:04:05.000000006Z",
+			2*time.Second,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime.Add(2*time.Second)).IsWithinDuration(exampleTime, time.Second)
+	})
+
+	t.Run("when times are far apart", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			time.Second,
+			"0001-01-01T00:00:00Z",
+			"2020-01-02T03:04:05.000000006Z",
+			time.Duration(math.MinInt64),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(time.Time{}).IsWithinDuration(exampleTime, time.Second)
+	})
+
+	t.Run("when tolerance is negative", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Tolerance must not be negative, got: %s", -time.Second).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).IsWithinDuration(exampleTime, -time.Second)
+	})
+
+	t.Run("when actual is not a time", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected time.Time", "2020-01-02").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("2020-01-02").IsWithinDuration(exampleTime, time.Second)
+	})
+
+	t.Run("when negated and within tolerance", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s",
+			"be within 1s of",
+			`  "2020-01-02T03:04:05.000000006Z"`,
+			"  time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC)",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).Not().IsWithinDuration(exampleTime, time.Second)
+	})
+}
+
+func TestChainIsBefore(t *testing.T) {
+	exampleTime := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	t.Run("when before", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).IsBefore(exampleTime.Add(time.Nanosecond))
+	})
+
+	t.Run("when equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not before expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			"2020-01-02T03:04:05.000000006Z",
+			"2020-01-02T03:04:05.000000006Z",
+			time.Duration(0),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).IsBefore(exampleTime)
+	})
+
+	t.Run("when after", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not before expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			"2020-01-02T03:04:05.000000006Z",
+			"2020-01-02T02:04:05.000000006Z",
+			time.Hour,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).IsBefore(exampleTime.Add(-time.Hour))
+	})
+
+	t.Run("when actual is not a time", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected time.Time", &exampleTime).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(&exampleTime).IsBefore(exampleTime)
+	})
+}
+
+func TestChainIsAfter(t *testing.T) {
+	exampleTime := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	t.Run("when after", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).IsAfter(exampleTime.Add(-time.Nanosecond))
+	})
+
+	t.Run("when before", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not after expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			"2020-01-02T03:04:05.000000006Z",
+			"2020-01-02T04:04:05.000000006Z",
+			-time.Hour,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(exampleTime).IsAfter(exampleTime.Add(time.Hour))
+	})
+
+	t.Run("when actual is not a time", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected time.Time", nil).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(nil).IsAfter(exampleTime)
+	})
+}
+
+func TestChainIsBetween(t *testing.T) {
+	start := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	end := start.Add(time.Hour)
+
+	t.Run("when between", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(start.Add(time.Minute)).IsBetween(start, end)
+	})
+
+	t.Run("when equal to start", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(start).IsBetween(start, end)
+	})
+
+	t.Run("when equal to end", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(end).IsBetween(start, end)
+	})
+
+	t.Run("when before start", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not between start and end:\n\tActual:     %s\n\tStart:      %s\n\tEnd:        %s\n\tDifference: %s",
+			"2020-01-02T03:03:05.000000006Z",
+			"2020-01-02T03:04:05.000000006Z",
+			"2020-01-02T04:04:05.000000006Z",
+			"1m0s before start",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(start.Add(-time.Minute)).IsBetween(start, end)
+	})
+
+	t.Run("when after end", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual time is not between start and end:\n\tActual:     %s\n\tStart:      %s\n\tEnd:        %s\n\tDifference: %s",
+			"2020-01-02T04:04:05.000000007Z",
+			"2020-01-02T03:04:05.000000006Z",
+			"2020-01-02T04:04:05.000000006Z",
+			"1ns after end",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(end.Add(time.Nanosecond)).IsBetween(start, end)
+	})
+
+	t.Run("when end is before start", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"End must not be before start:\n\tStart: %s\n\tEnd:   %s",
+			"2020-01-02T04:04:05.000000006Z",
+			"2020-01-02T03:04:05.000000006Z",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(start).IsBetween(end, start)
+	})
+
+	t.Run("when actual is not a time", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected time.Time", 123).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(123).IsBetween(start, end)
+	})
+}
+
+func TestChainIsDurationWithin(t *testing.T) {
+	t.Run("when within tolerance", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(1100*time.Millisecond).IsDurationWithin(time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("when not within tolerance", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual duration is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			100*time.Millisecond,
+			800*time.Millisecond,
+			time.Second,
+			-200*time.Millisecond,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(800*time.Millisecond).IsDurationWithin(time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("when durations are far apart", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual duration is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			time.Second,
+			time.Duration(1<<62),
+			-time.Duration(1<<62),
+			time.Duration(math.MaxInt64),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(time.Duration(1<<62)).IsDurationWithin(-time.Duration(1<<62), time.Second)
+	})
+
+	t.Run("when durations are far apart in the other direction", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual duration is not within %s of expected:\n\tActual:     %s\n\tExpected:   %s\n\tDifference: %s",
+			time.Duration(math.MaxInt64),
+			time.Duration(math.MinInt64),
+			time.Duration(1),
+			time.Duration(math.MinInt64),
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(time.Duration(math.MinInt64)).IsDurationWithin(1, math.MaxInt64)
+	})
+
+	t.Run("when within the maximum tolerance", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensure(time.Duration(math.MinInt64)).IsDurationWithin(-1, math.MaxInt64)
+	})
+
+	t.Run("when tolerance is negative", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Tolerance must not be negative, got: %s", -time.Millisecond).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(time.Second).IsDurationWithin(time.Second, -time.Millisecond)
+	})
+
+	t.Run("when actual is not a duration", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Got type %T, expected time.Duration", int64(1)).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(int64(1)).IsDurationWithin(time.Second, time.Millisecond)
+	})
+}
@@ -0,0 +1,141 @@
+package ensuring
+
+import (
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// colorEnv controls colorized failure output. It can be set to auto (the default), always, or never.
+	// In auto mode, colors are used when stdout is a terminal, unless NO_COLOR is set.
+	colorEnv   = "ENSURE_COLOR"
+	noColorEnv = "NO_COLOR"
+
+	colorAlways = "always"
+	colorNever  = "never"
+
+	ansiReset      = "\x1b[0m"
+	ansiDim        = "\x1b[2m"
+	ansiRed        = "\x1b[31m"
+	ansiGreen      = "\x1b[32m"
+	ansiCyan       = "\x1b[36m"
+	ansiReverse    = "\x1b[7m"
+	ansiNotReverse = "\x1b[27m"
+)
+
+//nolint:gochecknoglobals // This is stored as a variable so we can override it for tests in init_test.go.
+var isTerminalFunc = stdoutIsTerminal
+
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// palette colorizes failure output. When colors are disabled, strings are returned unchanged.
+type palette struct {
+	enabled bool
+}
+
+// newPalette creates a palette based on the ENSURE_COLOR and NO_COLOR environment variables.
+// An explicit ENSURE_COLOR=always takes precedence over NO_COLOR.
+func newPalette() palette {
+	switch strings.ToLower(os.Getenv(colorEnv)) {
+	case colorAlways:
+		return palette{enabled: true}
+	case colorNever:
+		return palette{enabled: false}
+	default:
+		return palette{enabled: os.Getenv(noColorEnv) == "" && isTerminalFunc()}
+	}
+}
+
+func (p palette) paint(code, str string) string {
+	if !p.enabled || str == "" {
+		return str
+	}
+
+	return code + str + ansiReset
+}
+
+func (p palette) removed(str string) string { return p.paint(ansiRed, str) }
+func (p palette) added(str string) string   { return p.paint(ansiGreen, str) }
+func (p palette) dim(str string) string     { return p.paint(ansiDim, str) }
+
+// highlightChanges colors actual as removed and expected as added, and highlights the characters that differ
+// between them, after the common prefix and before the common suffix.
+func (p palette) highlightChanges(actual, expected string) (string, string) {
+	if !p.enabled {
+		return actual, expected
+	}
+
+	prefix := commonPrefixLength(actual, expected)
+	suffix := commonSuffixLength(actual[prefix:], expected[prefix:])
+
+	highlight := func(code, str string) string {
+		changed := str[prefix : len(str)-suffix]
+		if changed == "" {
+			return p.paint(code, str)
+		}
+
+		return code + str[:prefix] + ansiReverse + changed + ansiNotReverse + str[len(str)-suffix:] + ansiReset
+	}
+
+	return highlight(ansiRed, actual), highlight(ansiGreen, expected)
+}
+
+// colorLineDiff colors a unified diff created by [diff.Lines]. Removed lines are red, added lines are green,
+// hunk headers are cyan, and unchanged lines are dimmed.
+func (p palette) colorLineDiff(lineDiff string) string {
+	if !p.enabled {
+		return lineDiff
+	}
+
+	lines := strings.Split(lineDiff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			lines[i] = p.removed(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = p.added(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = p.paint(ansiCyan, line)
+		default:
+			lines[i] = p.dim(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// commonPrefixLength returns the length in bytes of the common prefix, without splitting a rune.
+func commonPrefixLength(a, b string) int {
+	length := 0
+	for length < len(a) && length < len(b) {
+		aRune, aSize := utf8.DecodeRuneInString(a[length:])
+		bRune, bSize := utf8.DecodeRuneInString(b[length:])
+		if aRune != bRune || aSize != bSize {
+			break
+		}
+
+		length += aSize
+	}
+
+	return length
+}
+
+// commonSuffixLength returns the length in bytes of the common suffix, without splitting a rune.
+func commonSuffixLength(a, b string) int {
+	length := 0
+	for length < len(a) && length < len(b) {
+		aRune, aSize := utf8.DecodeLastRuneInString(a[:len(a)-length])
+		bRune, bSize := utf8.DecodeLastRuneInString(b[:len(b)-length])
+		if aRune != bRune || aSize != bSize {
+			break
+		}
+
+		length += aSize
+	}
+
+	return length
+}
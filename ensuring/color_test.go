@@ -0,0 +1,164 @@
+package ensuring_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+)
+
+func TestColorOutput(t *testing.T) {
+	const (
+		reset      = "\x1b[0m"
+		dim        = "\x1b[2m"
+		red        = "\x1b[31m"
+		green      = "\x1b[32m"
+		cyan       = "\x1b[36m"
+		reverse    = "\x1b[7m"
+		notReverse = "\x1b[27m"
+	)
+
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	t.Run("when strings are not equal", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "always")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual %s does not equal expected %s:\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"string",
+			"string",
+			"  "+red+`"ab`+reverse+"c"+notReverse+`"`+reset,
+			"  "+green+`"ab`+reverse+"d"+notReverse+`"`+reset,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc").Equals("abd")
+	})
+
+	t.Run("when multiline strings are not equal", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "always")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual %s does not equal expected %s:\n\nDIFF (-actual +expected):\n%s",
+			"string",
+			"string",
+			"  "+cyan+"@@ -1,2 +1,2 @@"+reset+"\n"+
+				"  "+dim+" abc"+reset+"\n"+
+				"  "+red+"-xyz"+reset+"\n"+
+				"  "+green+"+xyw"+reset,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure("abc\nxyz\n").Equals("abc\nxyw\n")
+	})
+
+	t.Run("when structs are not equal", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "always")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\n%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"Actual does not equal expected:\n - Age: "+red+reverse+"1"+notReverse+reset+" != "+green+reverse+"2"+notReverse+reset,
+			dim+"  ensuring_test.Person{Name:\"Mary\", Age:1}"+reset,
+			dim+"  ensuring_test.Person{Name:\"Mary\", Age:2}"+reset,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(Person{Name: "Mary", Age: 1}).Equals(Person{Name: "Mary", Age: 2})
+	})
+
+	t.Run("when IsError fails", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "always")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s",
+			red+"error "+reverse+"a"+notReverse+reset,
+			green+"error "+reverse+"b"+notReverse+reset,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("error a")).IsError(errors.New("error b"))
+	})
+
+	t.Run("when MatchesAllErrors fails", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "always")
+
+		mockT := setupMockTWithCleanupCheck(t)
+
+		err1 := errors.New("my error")
+		err2 := errors.New("other error")
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not all of the expected errors:\n\tActual:\n\t     %s\n\n\tExpected all of:%s",
+			red+"my error"+reset,
+			"\n\t  "+green+"✅ my error"+reset+"\n\t  "+red+"❌ other error"+reset,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(err1).MatchesAllErrors(err1, err2)
+	})
+
+	t.Run("when ENSURE_COLOR is always and NO_COLOR is set", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "always")
+		t.Setenv("NO_COLOR", "1")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s",
+			red+"error "+reverse+"a"+notReverse+reset,
+			green+"error "+reverse+"b"+notReverse+reset,
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("error a")).IsError(errors.New("error b"))
+	})
+
+	t.Run("when ENSURE_COLOR is never", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "never")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s",
+			"error a",
+			"error b",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("error a")).IsError(errors.New("error b"))
+	})
+
+	t.Run("when ENSURE_COLOR is auto and output is not a terminal", func(t *testing.T) {
+		t.Setenv("ENSURE_COLOR", "auto")
+
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual error is not the expected error:\n\tActual:   %s\n\tExpected: %s",
+			"error a",
+			"error b",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure(errors.New("error a")).IsError(errors.New("error b"))
+	})
+}
@@ -0,0 +1,34 @@
+package ensuring
+
+import (
+	"strings"
+
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+// Diff describes the differences between the actual and expected values, using the same
+// format and comparison rules as [Chain.Equals]. It returns an empty string if they are equal.
+//
+// It is used by mocks generated by `ensure mocks generate` to describe mismatched arguments.
+func Diff(actual, expected interface{}, opts ...EqualsOption) string {
+	differences := checkEquality(actual, expected, opts)
+	if len(differences) == 0 {
+		return ""
+	}
+
+	return strings.TrimPrefix(formatDifferences(differences), "\n")
+}
+
+// formatDifferences formats each difference on its own line, prefixed with a dash.
+// When colors are enabled, actual values are red, and expected values are green.
+func formatDifferences(differences []diff.Difference) string {
+	p := newPalette()
+
+	formatted := ""
+	for _, difference := range differences {
+		difference.Actual, difference.Expected = p.highlightChanges(difference.Actual, difference.Expected)
+		formatted += "\n - " + strings.ReplaceAll(difference.String(), "\n", "\n   ")
+	}
+
+	return formatted
+}
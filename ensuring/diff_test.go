@@ -0,0 +1,36 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure/ensuring"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("when equal", func(t *testing.T) {
+		result := ensuring.Diff(ExamplePerson{Name: "John"}, ExamplePerson{Name: "John"})
+		if result != "" {
+			t.Errorf("expected no diff, got: %q", result)
+		}
+	})
+
+	t.Run("when not equal", func(t *testing.T) {
+		result := ensuring.Diff(
+			ExamplePerson{Name: "John", Messages: []ExampleMessage{{Body: "Hello"}}},
+			ExamplePerson{Name: "Sam", Messages: []ExampleMessage{{Body: "Greetings"}}},
+		)
+
+		expected := " - Name: \"John\" != \"Sam\"\n" +
+			" - Messages[0].Body: \"Hello\" != \"Greetings\""
+		if result != expected {
+			t.Errorf("expected diff:\n%s\n\ngot:\n%s", expected, result)
+		}
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		result := ensuring.Diff(ExamplePerson{Name: "John"}, ExamplePerson{Name: "Sam"}, ensuring.IgnoreFields("Name"))
+		if result != "" {
+			t.Errorf("expected no diff, got: %q", result)
+		}
+	})
+}
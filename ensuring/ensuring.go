@@ -30,6 +30,19 @@ type Chain struct {
 	ctx    testctx.Context
 	actual interface{}
 	wasRun bool
+
+	// Used to negate assertions. See [Chain.Not].
+	negated          bool
+	assertionDepth   int
+	assertionFailed  bool
+	assertionInvalid bool
+
+	// Included in failure messages. See [Chain.Because] and [E.WithContext].
+	reasons []string
+	context []contextEntry
+
+	// Set within [E.RunSync] and [E.RunTableByIndexSync]. See [E.Eventually].
+	inSyncBubble bool
 }
 
 // InternalCreateDoNotCallDirectly should NOT be called directly.
@@ -65,6 +78,7 @@ func (e E) Failf(format string, args ...interface{}) {
 	c := e(nil)
 	c.t.Helper()
 	c.markRun()
+	format, args = c.annotate(format, args)
 	c.t.Fatalf(format, args...)
 }
 
@@ -76,7 +90,7 @@ func (e E) T() *testing.T {
 	c := e(nil)
 	c.markRun()
 
-	t, ok := c.t.(*testing.T)
+	t, ok := unwrapT(c.t).(*testing.T)
 	if !ok {
 		c.t.Helper()
 		c.t.Fatalf("An instance of *testing.T was not provided to ensure.New(t), thus T() cannot be used.")
@@ -105,14 +119,17 @@ func (e E) GoMockController() *gomock.Controller {
 
 func wrap(t T) E {
 	// Created outside the callback, so the same context is used across ensure calls
-	ctx := newTestContext(t)
+	return wrapWithContext(t, newTestContext(t), false)
+}
 
+func wrapWithContext(t T, ctx testctx.Context, inSyncBubble bool) E {
 	return func(actual interface{}) *Chain {
 		c := &Chain{
-			t:      t,
-			ctx:    ctx,
-			actual: actual,
-			wasRun: false,
+			t:            t,
+			ctx:          ctx,
+			actual:       actual,
+			wasRun:       false,
+			inSyncBubble: inSyncBubble,
 		}
 
 		// Cleanup should never call Fatalf, otherwise panics are hidden, and
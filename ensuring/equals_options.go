@@ -0,0 +1,82 @@
+package ensuring
+
+import "github.com/JosiahWitt/ensure/internal/diff"
+
+// EqualsOption customizes how [Chain.Equals] compares values.
+// An option only applies to the Equals call it is provided to.
+type EqualsOption struct {
+	apply func(opts *diff.Options)
+}
+
+// IgnoreFields skips struct fields with any of the provided names, at any depth.
+//
+// For example:
+//
+//	ensure(actual).Equals(expected, ensuring.IgnoreFields("CreatedAt", "UpdatedAt"))
+func IgnoreFields(names ...string) EqualsOption {
+	return EqualsOption{
+		apply: func(opts *diff.Options) {
+			opts.IgnoredFields = append(opts.IgnoredFields, names...)
+		},
+	}
+}
+
+// IgnoreUnexported skips unexported struct fields.
+// By default, unexported struct fields are compared.
+func IgnoreUnexported() EqualsOption {
+	return EqualsOption{
+		apply: func(opts *diff.Options) {
+			opts.IgnoreUnexported = true
+		},
+	}
+}
+
+// NilEqualsEmpty treats nil slices and maps as equal to empty slices and maps.
+// By default, nil slices and maps are not equal to empty slices and maps.
+func NilEqualsEmpty() EqualsOption {
+	return EqualsOption{
+		apply: func(opts *diff.Options) {
+			opts.NilEqualsEmpty = true
+		},
+	}
+}
+
+// CompareTimesWithEqual compares [time.Time] values using [time.Time.Equal], including times in unexported
+// struct fields, so monotonic clock readings and locations are ignored. By default, exported times are
+// already compared using [time.Time.Equal], but unexported times are compared field by field.
+//
+// For example:
+//
+//	ensure(actual).Equals(expected, ensuring.CompareTimesWithEqual())
+func CompareTimesWithEqual() EqualsOption {
+	return EqualsOption{
+		apply: func(opts *diff.Options) {
+			opts.CompareTimesWithEqual = true
+		},
+	}
+}
+
+// CompareReferences compares channels and unsafe pointers by identity, and functions by whether they are nil.
+// By default, channels, functions, and unsafe pointers are skipped.
+//
+// For example:
+//
+//	ensure(actual).Equals(expected, ensuring.CompareReferences())
+func CompareReferences() EqualsOption {
+	return EqualsOption{
+		apply: func(opts *diff.Options) {
+			opts.CompareReferences = true
+		},
+	}
+}
+
+func buildDiffOptions(opts []EqualsOption) diff.Options {
+	diffOpts := diff.Options{}
+	for _, opt := range opts {
+		if opt.apply != nil {
+			opt.apply(&diffOpts)
+		}
+	}
+
+	return diffOpts
+}
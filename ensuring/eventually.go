@@ -0,0 +1,179 @@
+package ensuring
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kr/text"
+)
+
+// minAttemptDuration is the minimum time an attempt of [E.Eventually] can run before it is abandoned.
+const minAttemptDuration = 100 * time.Millisecond
+
+// Eventually runs fn with a scoped ensure instance every interval, until all of its assertions pass.
+// If they have not passed before the timeout, the test fails with the failures from the last attempt.
+//
+// Each attempt stops at its first fatal failure, and runs in a separate goroutine, similar to [testing.T.Run].
+// An attempt that is still running at the timeout is abandoned, and the test fails. Each attempt can run for
+// at least 100ms, so an attempt that starts just before the timeout can still finish.
+//
+// When used within [E.RunSync] or [E.RunTableByIndexSync], time is provided by the [synctest] fake clock,
+// so waiting for the interval returns instantly once all goroutines in the bubble are durably blocked.
+// Attempts are not abandoned, since waiting for them does not take real time, and the bubble cannot end
+// while they are running. An attempt that started before the timeout can still pass after it.
+//
+// For example:
+//
+//	ensure.Eventually(time.Second, 10*time.Millisecond, func(ensure ensuring.E) {
+//	  ensure(cache.Len()).Equals(3)
+//	})
+func (e E) Eventually(timeout, interval time.Duration, fn func(ensure E)) {
+	c := e(nil)
+	c.t.Helper()
+	c.markRun()
+
+	if interval <= 0 {
+		c.t.Fatalf("Interval must be positive, got: %s", interval)
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 1; ; attempt++ {
+		attemptDeadline := deadline
+		if minDeadline := time.Now().Add(minAttemptDuration); minDeadline.After(attemptDeadline) {
+			attemptDeadline = minDeadline
+		}
+
+		if c.inSyncBubble {
+			attemptDeadline = time.Time{}
+		}
+
+		failures, finished := runAttempt(c, fn, attemptDeadline)
+		if !finished {
+			format, args := c.annotate(
+				"\nEventually timed out after %s. Attempt %d was still running.",
+				[]interface{}{timeout, attempt},
+			)
+			c.t.Fatalf(format, args...)
+			return
+		}
+
+		if len(failures) == 0 {
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			format, args := c.annotate(
+				"\nEventually timed out after %s. Attempt %d failed with:\n\n%s",
+				[]interface{}{timeout, attempt, formatAttemptFailures(failures)},
+			)
+			c.t.Fatalf(format, args...)
+			return
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Consistently runs fn with a scoped ensure instance every interval, ensuring all of its assertions pass
+// for the entire duration. If any attempt fails, the test fails immediately with the failures from that attempt.
+//
+// Each attempt stops at its first fatal failure, and runs in a separate goroutine, similar to [testing.T.Run].
+// When used within [E.RunSync] or [E.RunTableByIndexSync], time is provided by the [synctest] fake clock,
+// so waiting for the interval returns instantly once all goroutines in the bubble are durably blocked.
+//
+// For example:
+//
+//	ensure.Consistently(time.Second, 10*time.Millisecond, func(ensure ensuring.E) {
+//	  ensure(server.IsHealthy()).IsTrue()
+//	})
+func (e E) Consistently(duration, interval time.Duration, fn func(ensure E)) {
+	c := e(nil)
+	c.t.Helper()
+	c.markRun()
+
+	if interval <= 0 {
+		c.t.Fatalf("Interval must be positive, got: %s", interval)
+		return
+	}
+
+	start := time.Now()
+	deadline := start.Add(duration)
+
+	for attempt := 1; ; attempt++ {
+		failures, _ := runAttempt(c, fn, time.Time{})
+		if len(failures) > 0 {
+			format, args := c.annotate(
+				"\nConsistently failed after %s. Attempt %d failed with:\n\n%s",
+				[]interface{}{time.Since(start), attempt, formatAttemptFailures(failures)},
+			)
+			c.t.Fatalf(format, args...)
+			return
+		}
+
+		if !time.Now().Before(deadline) {
+			return
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// runAttempt runs fn in a separate goroutine, returning any failures that were recorded.
+// Panics are propagated to the calling goroutine.
+//
+// If the deadline is not zero, and the attempt is still running at the deadline, it is abandoned, and finished is
+// false. Failures recorded by an abandoned attempt are ignored.
+func runAttempt(c *Chain, fn func(ensure E), deadline time.Time) (failures []string, finished bool) {
+	at := &recordingT{T: c.t, exitOnFatal: true}
+
+	var (
+		panicked   bool
+		panicValue interface{}
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				panicked = true
+				panicValue = r
+			}
+		}()
+
+		// The parent context is reused, so the same GoMock controller is shared with each attempt.
+		fn(wrapWithContext(at, c.ctx, c.inSyncBubble))
+	}()
+
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+
+		timeout = timer.C
+	}
+
+	select {
+	case <-done:
+	case <-timeout:
+		return nil, false
+	}
+
+	failures = at.close()
+	if panicked {
+		panic(panicValue)
+	}
+
+	return failures, true
+}
+
+func formatAttemptFailures(failures []string) string {
+	formattedFailures := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		formattedFailures = append(formattedFailures, text.Indent(strings.TrimPrefix(failure, "\n"), indent))
+	}
+
+	return strings.Join(formattedFailures, "\n\n")
+}
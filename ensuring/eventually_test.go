@@ -0,0 +1,258 @@
+package ensuring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEEventually(t *testing.T) {
+	t.Run("when assertions pass on the first attempt", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		attempts := 0
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(time.Second, time.Millisecond, func(ensure ensuring.E) {
+			attempts++
+			ensure(true).IsTrue()
+		})
+
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got: %d", attempts)
+		}
+	})
+
+	t.Run("when assertions pass after a few attempts", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).Times(3)
+
+		attempts := 0
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(time.Minute, time.Millisecond, func(ensure ensuring.E) {
+			attempts++
+			ensure(attempts).Equals(3)
+		})
+
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got: %d", attempts)
+		}
+	})
+
+	t.Run("when assertions never pass", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).AnyTimes()
+
+		mockT.EXPECT().Fatalf(
+			"\nEventually timed out after %s. Attempt %d failed with:\n\n%s",
+			10*time.Millisecond,
+			gomock.Any(),
+			"  Got false, expected true",
+		)
+
+		afterFailure := false
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(10*time.Millisecond, time.Millisecond, func(ensure ensuring.E) {
+			ensure(false).IsTrue()
+			afterFailure = true
+		})
+
+		if afterFailure {
+			t.Error("expected the attempt to stop after the first fatal failure")
+		}
+	})
+
+	t.Run("when attempt is still running at the timeout", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+
+		mockT.EXPECT().Fatalf("\nEventually timed out after %s. Attempt %d was still running.", 10*time.Millisecond, 1)
+
+		blocked := make(chan struct{})
+		defer close(blocked)
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(10*time.Millisecond, time.Millisecond, func(ensure ensuring.E) {
+			<-blocked
+		})
+	})
+
+	t.Run("when timeout is zero", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		mockT.EXPECT().Fatalf(
+			"\nEventually timed out after %s. Attempt %d failed with:\n\n%s",
+			time.Duration(0),
+			1,
+			"  Actual string does not equal expected string:\n"+
+				"\n"+
+				"  ACTUAL:\n"+
+				"    \"abc\"\n"+
+				"\n"+
+				"  EXPECTED:\n"+
+				"    \"xyz\"",
+		)
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(0, time.Millisecond, func(ensure ensuring.E) {
+			ensure("abc").Equals("xyz")
+		})
+	})
+
+	t.Run("when only the last attempt's failures are reported", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).AnyTimes()
+
+		attempts := 0
+
+		mockT.EXPECT().Fatalf(
+			"\nEventually timed out after %s. Attempt %d failed with:\n\n%s",
+			10*time.Millisecond,
+			gomock.Any(),
+			"  attempt failed\n\n  last attempt",
+		)
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(10*time.Millisecond, time.Millisecond, func(ensure ensuring.E) {
+			attempts++
+			ensure.InterfaceT().Errorf("attempt failed")
+
+			if attempts == 1 {
+				ensure.Failf("first attempt")
+			}
+
+			ensure.Failf("last attempt")
+		})
+	})
+
+	t.Run("when interval is not positive", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Interval must be positive, got: %s", time.Duration(0)).After(
+			mockT.EXPECT().Helper(),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure.Eventually(time.Second, 0, func(ensure ensuring.E) {
+			t.Error("expected the callback not to be called")
+		})
+	})
+
+	t.Run("when attempt panics", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+
+		ensure := ensure.New(mockT)
+
+		defer func() {
+			if r := recover(); r != "oops" {
+				t.Errorf("expected the panic to be propagated, got: %v", r)
+			}
+		}()
+
+		ensure.Eventually(time.Second, time.Millisecond, func(ensure ensuring.E) {
+			panic("oops")
+		})
+	})
+
+	t.Run("shares the GoMock controller", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).AnyTimes() // Setup by GoMock Controller and ensure
+
+		ensure := ensure.New(mockT)
+		outerController := ensure.GoMockController()
+
+		ensure.Eventually(time.Second, time.Millisecond, func(ensure ensuring.E) {
+			if ensure.GoMockController() != outerController {
+				t.Error("expected the GoMock controller to be shared with each attempt")
+			}
+		})
+	})
+}
+
+func TestEConsistently(t *testing.T) {
+	t.Run("when assertions pass for the entire duration", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).AnyTimes()
+
+		attempts := 0
+
+		ensure := ensure.New(mockT)
+		ensure.Consistently(10*time.Millisecond, time.Millisecond, func(ensure ensuring.E) {
+			attempts++
+			ensure(true).IsTrue()
+		})
+
+		if attempts < 2 {
+			t.Errorf("expected at least 2 attempts, got: %d", attempts)
+		}
+	})
+
+	t.Run("when duration is zero", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		attempts := 0
+
+		ensure := ensure.New(mockT)
+		ensure.Consistently(0, time.Millisecond, func(ensure ensuring.E) {
+			attempts++
+			ensure(true).IsTrue()
+		})
+
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got: %d", attempts)
+		}
+	})
+
+	t.Run("when an attempt fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).Times(3)
+
+		attempts := 0
+
+		mockT.EXPECT().Fatalf(
+			"\nConsistently failed after %s. Attempt %d failed with:\n\n%s",
+			gomock.Any(),
+			3,
+			"  Got false, expected true",
+		)
+
+		ensure := ensure.New(mockT)
+		ensure.Consistently(time.Minute, time.Millisecond, func(ensure ensuring.E) {
+			attempts++
+			ensure(attempts < 3).IsTrue()
+		})
+
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got: %d", attempts)
+		}
+	})
+
+	t.Run("when interval is not positive", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Interval must be positive, got: %s", -time.Second).After(
+			mockT.EXPECT().Helper(),
+		)
+
+		ensure := ensure.New(mockT)
+		ensure.Consistently(time.Second, -time.Second, func(ensure ensuring.E) {
+			t.Error("expected the callback not to be called")
+		})
+	})
+}
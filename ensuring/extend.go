@@ -0,0 +1,87 @@
+package ensuring
+
+import "fmt"
+
+// Assertion is provided to custom assertions created with [Extend].
+// It exposes the actual value, and reports failures the same way as the built-in assertions.
+type Assertion struct {
+	c *Chain
+
+	failed  bool
+	invalid bool
+	message string
+}
+
+// Extend runs a custom assertion on the chain, so packages can define domain-specific assertions that behave like
+// the built-in ones. The chain is marked as run, and the assertion supports [Chain.Not], where the description is
+// used in the negated failure message.
+//
+// The assertion reports failures using [Assertion.Failf] or [Assertion.Invalidf]. Only the first failure is
+// reported, once the assertion returns. To report the line of the test that called the custom assertion,
+// the custom assertion should call c.T().Helper().
+//
+// For example:
+//
+//	func IsPositive(c *ensuring.Chain) {
+//		c.T().Helper()
+//		ensuring.Extend(c, "be a positive amount", func(a *ensuring.Assertion) {
+//			amount, ok := a.Actual().(money.Amount)
+//			if !ok {
+//				a.Invalidf("Got type %T, expected money.Amount", a.Actual())
+//				return
+//			}
+//
+//			if amount.Cents <= 0 {
+//				a.Failf("Got %s, expected a positive amount", amount)
+//			}
+//		})
+//	}
+func Extend(c *Chain, description string, assert func(a *Assertion)) {
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion(description)()
+
+	a := &Assertion{c: c}
+	assert(a)
+
+	switch {
+	case a.invalid:
+		c.invalid("%s", a.message)
+	case a.failed:
+		c.fail("%s", a.message)
+	}
+}
+
+// T exposes the [T] scoped to the chain, without marking the chain as run.
+// It is intended for custom assertions created with [Extend], which should call c.T().Helper().
+func (c *Chain) T() T {
+	return c.t
+}
+
+// Actual returns the actual value provided to ensure(actual).
+func (a *Assertion) Actual() interface{} {
+	return a.c.actual
+}
+
+// Failf fails the assertion with a formatted message, once the custom assertion returns.
+// When the chain is negated, the failure is expected, so the test does not fail.
+func (a *Assertion) Failf(format string, args ...interface{}) {
+	a.report(false, format, args)
+}
+
+// Invalidf fails the test with a formatted message, once the custom assertion returns.
+// It is intended for when the assertion cannot be checked, for example, when the actual value has an unsupported
+// type. It fails the test even when the chain is negated.
+func (a *Assertion) Invalidf(format string, args ...interface{}) {
+	a.report(true, format, args)
+}
+
+func (a *Assertion) report(invalid bool, format string, args []interface{}) {
+	if a.failed || a.invalid {
+		return
+	}
+
+	a.failed = !invalid
+	a.invalid = invalid
+	a.message = fmt.Sprintf(format, args...)
+}
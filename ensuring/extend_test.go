@@ -0,0 +1,98 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+)
+
+func isPositive(c *ensuring.Chain) {
+	c.T().Helper()
+	ensuring.Extend(c, "be positive", func(a *ensuring.Assertion) {
+		actual, ok := a.Actual().(int)
+		if !ok {
+			a.Invalidf("Got type %T, expected int", a.Actual())
+			return
+		}
+
+		if actual <= 0 {
+			a.Failf("Got %d, expected it to be positive", actual)
+		}
+	})
+}
+
+func TestExtend(t *testing.T) {
+	t.Run("when custom assertion succeeds", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		isPositive(ensure(1))
+	})
+
+	t.Run("when custom assertion fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("%s", "Got -1, expected it to be positive").After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		isPositive(ensure(-1))
+	})
+
+	t.Run("when custom assertion is invalid", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("%s", "Got type string, expected int").After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		isPositive(ensure("abc"))
+	})
+
+	t.Run("when custom assertion fails multiple times", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("%s", "first").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.Extend(ensure(1), "fail twice", func(a *ensuring.Assertion) {
+			a.Failf("first")
+			a.Invalidf("second")
+		})
+	})
+
+	t.Run("when negated custom assertion fails", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(3)
+
+		ensure := ensure.New(mockT)
+		isPositive(ensure(-1).Not())
+	})
+
+	t.Run("when negated custom assertion succeeds", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual was expected NOT to %s\n\nACTUAL:\n%s",
+			"be positive",
+			"  int(1)",
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		isPositive(ensure(1).Not())
+	})
+
+	t.Run("when negated custom assertion is invalid", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("%s", "Got type string, expected int").After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		isPositive(ensure("abc").Not())
+	})
+}
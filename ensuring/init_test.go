@@ -1,7 +1,11 @@
 //nolint:testpackage // Only used for the init function below.
 package ensuring
 
-import "github.com/JosiahWitt/ensure/ensuring/internal/testhelper"
+import (
+	"os"
+
+	"github.com/JosiahWitt/ensure/ensuring/internal/testhelper"
+)
 
 //nolint:gochecknoinits // Only to make testing easier.
 func init() {
@@ -9,4 +13,10 @@ func init() {
 	// This allows us to continue to keep the tests in the separate testing package and keep
 	// the newTestContextFunc variable unexported.
 	newTestContextFunc = testhelper.NewTestContext
+
+	// Colors are only enabled in tests by setting ENSURE_COLOR within the test, so the output does not depend on the
+	// terminal or the environment.
+	isTerminalFunc = func() bool { return false }
+	_ = os.Unsetenv("ENSURE_COLOR")
+	_ = os.Unsetenv("NO_COLOR")
 }
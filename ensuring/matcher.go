@@ -0,0 +1,343 @@
+package ensuring
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/JosiahWitt/ensure/internal/diff"
+	"go.uber.org/mock/gomock"
+)
+
+// Matcher matches values, and is used by [Chain.Matches].
+//
+// Matchers also implement [gomock.Matcher], so they can be passed to EXPECT() calls on mocks.
+// Mocks generated by `ensure mocks generate` include the explanation in failure messages.
+type Matcher interface {
+	gomock.Matcher
+
+	// Explain describes why actual does not match. It is only called when Matches returns false.
+	Explain(actual interface{}) string
+}
+
+type matcher struct {
+	description string
+
+	// match returns true if actual matches, otherwise it returns false with an explanation of why it doesn't match.
+	match func(actual interface{}) (bool, string)
+}
+
+var _ Matcher = &matcher{}
+
+func (m *matcher) Matches(actual interface{}) bool {
+	matches, _ := m.match(actual)
+	return matches
+}
+
+func (m *matcher) Explain(actual interface{}) string {
+	_, explanation := m.match(actual)
+	return explanation
+}
+
+func (m *matcher) String() string {
+	return m.description
+}
+
+// AllOf matches values that match every one of the provided matchers.
+//
+// For example:
+//
+//	ensure("abc").Matches(ensuring.AllOf(ensuring.HasPrefix("a"), ensuring.Len(3))) // Succeeds
+//	ensure("abc").Matches(ensuring.AllOf(ensuring.HasPrefix("a"), ensuring.Len(2))) // Fails
+func AllOf(matchers ...Matcher) Matcher {
+	return &matcher{
+		description: "all of (" + describeMatchers(matchers) + ")",
+		match: func(actual interface{}) (bool, string) {
+			explanations := []string{}
+			for _, m := range matchers {
+				if !m.Matches(actual) {
+					explanations = append(explanations, explainMismatch(m, actual))
+				}
+			}
+
+			if len(explanations) == 0 {
+				return true, ""
+			}
+
+			return false, strings.Join(explanations, "\n")
+		},
+	}
+}
+
+// AnyOf matches values that match at least one of the provided matchers.
+//
+// For example:
+//
+//	ensure("abc").Matches(ensuring.AnyOf(ensuring.HasPrefix("x"), ensuring.HasPrefix("a"))) // Succeeds
+//	ensure("abc").Matches(ensuring.AnyOf(ensuring.HasPrefix("x"), ensuring.HasPrefix("y"))) // Fails
+func AnyOf(matchers ...Matcher) Matcher {
+	return &matcher{
+		description: "any of (" + describeMatchers(matchers) + ")",
+		match: func(actual interface{}) (bool, string) {
+			explanations := make([]string, 0, len(matchers))
+			for _, m := range matchers {
+				if m.Matches(actual) {
+					return true, ""
+				}
+
+				explanations = append(explanations, " - "+strings.ReplaceAll(explainMismatch(m, actual), "\n", "\n   "))
+			}
+
+			return false, "none of the matchers matched:\n" + strings.Join(explanations, "\n")
+		},
+	}
+}
+
+// Not matches values that do not match the provided matcher.
+//
+// For example:
+//
+//	ensure("abc").Matches(ensuring.Not(ensuring.HasPrefix("x"))) // Succeeds
+//	ensure("abc").Matches(ensuring.Not(ensuring.HasPrefix("a"))) // Fails
+func Not(m Matcher) Matcher {
+	return &matcher{
+		description: "not (" + m.String() + ")",
+		match: func(actual interface{}) (bool, string) {
+			if m.Matches(actual) {
+				return false, prettyFormatValue(actual) + " unexpectedly matches " + m.String()
+			}
+
+			return true, ""
+		},
+	}
+}
+
+// HasPrefix matches strings and byte slices that start with the prefix.
+//
+// For example:
+//
+//	ensure("abc").Matches(ensuring.HasPrefix("ab")) // Succeeds
+//	ensure("abc").Matches(ensuring.HasPrefix("bc")) // Fails
+func HasPrefix(prefix string) Matcher {
+	return &matcher{
+		description: "has prefix " + strconv.Quote(prefix),
+		match: func(actual interface{}) (bool, string) {
+			actualStr, _, ok := isStringLike(actual)
+			if !ok {
+				return false, fmt.Sprintf("got type %T, expected string", actual)
+			}
+
+			if !strings.HasPrefix(actualStr, prefix) {
+				return false, strconv.Quote(actualStr) + " does not have prefix " + strconv.Quote(prefix)
+			}
+
+			return true, ""
+		},
+	}
+}
+
+// Regex matches strings and byte slices that match the regular expression pattern.
+// Like [regexp.MustCompile], it panics if the pattern cannot be compiled.
+//
+// For example:
+//
+//	ensure("abc").Matches(ensuring.Regex(`^a.c$`)) // Succeeds
+//	ensure("abc").Matches(ensuring.Regex(`^x`)) // Fails
+func Regex(pattern string) Matcher {
+	patternRegexp := regexp.MustCompile(pattern)
+
+	return &matcher{
+		description: "matches regexp " + strconv.Quote(pattern),
+		match: func(actual interface{}) (bool, string) {
+			actualStr, _, ok := isStringLike(actual)
+			if !ok {
+				return false, fmt.Sprintf("got type %T, expected string", actual)
+			}
+
+			if !patternRegexp.MatchString(actualStr) {
+				return false, strconv.Quote(actualStr) + " does not match regexp " + strconv.Quote(pattern)
+			}
+
+			return true, ""
+		},
+	}
+}
+
+// Len matches arrays, slices, strings, maps, and channels with the provided length.
+//
+// For example:
+//
+//	ensure([]int{1, 2}).Matches(ensuring.Len(2)) // Succeeds
+//	ensure([]int{1, 2}).Matches(ensuring.Len(3)) // Fails
+func Len(length int) Matcher {
+	return &matcher{
+		description: "has length " + strconv.Itoa(length),
+		match: func(actual interface{}) (bool, string) {
+			//nolint:exhaustive // Only kinds with a length are supported
+			switch reflectValue := reflect.ValueOf(actual); reflectValue.Kind() {
+			case reflect.Array, reflect.Slice, reflect.String, reflect.Map, reflect.Chan:
+				if actualLength := reflectValue.Len(); actualLength != length {
+					return false, fmt.Sprintf("has length %d, expected %d", actualLength, length)
+				}
+
+				return true, ""
+			default:
+				return false, fmt.Sprintf("got type %T, expected array, slice, string, map, or channel", actual)
+			}
+		},
+	}
+}
+
+// Field matches structs where the field at the dot separated path matches expected.
+// Pointers are followed when resolving the path. If expected is a [Matcher], it is used to match the field,
+// otherwise the field must equal expected, using the same rules as [Chain.Equals].
+//
+// For example:
+//
+//	ensure(user).Matches(ensuring.Field("Address.City", "Paris"))
+//	ensure(user).Matches(ensuring.Field("Name", ensuring.HasPrefix("M")))
+func Field(path string, expected interface{}) Matcher {
+	fieldMatcher := asMatcher(expected)
+
+	return &matcher{
+		description: "field " + path + " " + fieldMatcher.String(),
+		match: func(actual interface{}) (bool, string) {
+			value, err := fieldOf(actual, path)
+			if err != nil {
+				return false, err.Error()
+			}
+
+			if !fieldMatcher.Matches(value) {
+				return false, "field " + path + ": " + explainMismatch(fieldMatcher, value)
+			}
+
+			return true, ""
+		},
+	}
+}
+
+// Approx matches numbers that are within tolerance of expected.
+//
+// For example:
+//
+//	ensure(1.501).Matches(ensuring.Approx(1.5, 0.01)) // Succeeds
+//	ensure(1.6).Matches(ensuring.Approx(1.5, 0.01)) // Fails
+func Approx(expected, tolerance float64) Matcher {
+	return &matcher{
+		description: fmt.Sprintf("within %v of %v", tolerance, expected),
+		match: func(actual interface{}) (bool, string) {
+			actualFloat, ok := floatOf(actual)
+			if !ok {
+				return false, fmt.Sprintf("got type %T, expected a number", actual)
+			}
+
+			if difference := math.Abs(actualFloat - expected); !(difference <= tolerance) {
+				return false, fmt.Sprintf("%v differs from %v by %v, which is more than %v", actualFloat, expected, difference, tolerance)
+			}
+
+			return true, ""
+		},
+	}
+}
+
+// asMatcher returns expected if it is a Matcher, otherwise it returns a Matcher that checks equality with expected.
+func asMatcher(expected interface{}) Matcher {
+	if m, ok := expected.(Matcher); ok {
+		return m
+	}
+
+	return &matcher{
+		description: "equals " + diff.FormatValue(expected),
+		match: func(actual interface{}) (bool, string) {
+			differences := checkEquality(actual, expected, nil)
+			if len(differences) == 0 {
+				return true, ""
+			}
+
+			if len(differences) == 1 && differences[0].Path == "" {
+				return false, differences[0].String()
+			}
+
+			return false, "differs from expected:" + formatDifferences(differences)
+		},
+	}
+}
+
+// explainMismatch returns the explanation of why actual does not match m.
+// Since Matcher can be implemented outside this package, a generic explanation is used if the explanation is empty.
+func explainMismatch(m Matcher, actual interface{}) string {
+	if explanation := m.Explain(actual); explanation != "" {
+		return explanation
+	}
+
+	return prettyFormatValue(actual) + " does not match " + m.String()
+}
+
+func describeMatchers(matchers []Matcher) string {
+	descriptions := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		descriptions = append(descriptions, m.String())
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+func fieldOf(value interface{}, path string) (interface{}, error) {
+	reflectValue := reflect.ValueOf(value)
+
+	for _, name := range strings.Split(path, ".") {
+		for reflectValue.Kind() == reflect.Ptr || reflectValue.Kind() == reflect.Interface {
+			if reflectValue.IsNil() {
+				//nolint:err113 // Only used internally
+				return nil, fmt.Errorf("cannot get field %s from nil %s", name, reflectValue.Type())
+			}
+
+			reflectValue = reflectValue.Elem()
+		}
+
+		if reflectValue.Kind() != reflect.Struct {
+			//nolint:err113 // Only used internally
+			return nil, fmt.Errorf("cannot get field %s from type %s, expected struct", name, typeName(reflectValue))
+		}
+
+		field, ok := reflectValue.Type().FieldByName(name)
+		if !ok {
+			//nolint:err113 // Only used internally
+			return nil, fmt.Errorf("field %s does not exist on type %s", name, reflectValue.Type())
+		}
+
+		if !field.IsExported() {
+			//nolint:err113 // Only used internally
+			return nil, fmt.Errorf("field %s on type %s is unexported", name, reflectValue.Type())
+		}
+
+		reflectValue = reflectValue.FieldByIndex(field.Index)
+	}
+
+	return reflectValue.Interface(), nil
+}
+
+func typeName(reflectValue reflect.Value) string {
+	if !reflectValue.IsValid() {
+		return "nil"
+	}
+
+	return reflectValue.Type().String()
+}
+
+func floatOf(value interface{}) (float64, bool) {
+	//nolint:exhaustive // Only numeric kinds are supported
+	switch reflectValue := reflect.ValueOf(value); reflectValue.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(reflectValue.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(reflectValue.Uint()), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,277 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/ensuring/internal/testhelper"
+	"go.uber.org/mock/gomock"
+)
+
+type exampleAddress struct {
+	City string
+}
+
+type exampleUser struct {
+	Name    string
+	Address *exampleAddress
+
+	secret string
+}
+
+func TestMatchers(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name        string
+		Matcher     ensuring.Matcher
+		Actual      interface{}
+		Description string
+		Explanation string
+	}{
+		{
+			Name:        "HasPrefix with matching string",
+			Matcher:     ensuring.HasPrefix("ab"),
+			Actual:      "abc",
+			Description: `has prefix "ab"`,
+		},
+		{
+			Name:        "HasPrefix with matching byte slice",
+			Matcher:     ensuring.HasPrefix("ab"),
+			Actual:      []byte("abc"),
+			Description: `has prefix "ab"`,
+		},
+		{
+			Name:        "HasPrefix with mismatched string",
+			Matcher:     ensuring.HasPrefix("bc"),
+			Actual:      "abc",
+			Description: `has prefix "bc"`,
+			Explanation: `"abc" does not have prefix "bc"`,
+		},
+		{
+			Name:        "HasPrefix with non string",
+			Matcher:     ensuring.HasPrefix("1"),
+			Actual:      123,
+			Description: `has prefix "1"`,
+			Explanation: "got type int, expected string",
+		},
+		{
+			Name:        "Regex with matching string",
+			Matcher:     ensuring.Regex(`^a.c$`),
+			Actual:      "abc",
+			Description: `matches regexp "^a.c$"`,
+		},
+		{
+			Name:        "Regex with mismatched string",
+			Matcher:     ensuring.Regex(`^x`),
+			Actual:      "abc",
+			Description: `matches regexp "^x"`,
+			Explanation: `"abc" does not match regexp "^x"`,
+		},
+		{
+			Name:        "Regex with non string",
+			Matcher:     ensuring.Regex(`1`),
+			Actual:      1,
+			Description: `matches regexp "1"`,
+			Explanation: "got type int, expected string",
+		},
+		{
+			Name:        "Len with matching slice",
+			Matcher:     ensuring.Len(2),
+			Actual:      []int{1, 2},
+			Description: "has length 2",
+		},
+		{
+			Name:        "Len with matching map",
+			Matcher:     ensuring.Len(1),
+			Actual:      map[string]int{"a": 1},
+			Description: "has length 1",
+		},
+		{
+			Name:        "Len with mismatched string",
+			Matcher:     ensuring.Len(2),
+			Actual:      "abc",
+			Description: "has length 2",
+			Explanation: "has length 3, expected 2",
+		},
+		{
+			Name:        "Len with unsupported type",
+			Matcher:     ensuring.Len(2),
+			Actual:      2,
+			Description: "has length 2",
+			Explanation: "got type int, expected array, slice, string, map, or channel",
+		},
+		{
+			Name:        "Approx within tolerance",
+			Matcher:     ensuring.Approx(1.5, 0.01),
+			Actual:      1.505,
+			Description: "within 0.01 of 1.5",
+		},
+		{
+			Name:        "Approx with integer",
+			Matcher:     ensuring.Approx(2, 0.5),
+			Actual:      uint8(2),
+			Description: "within 0.5 of 2",
+		},
+		{
+			Name:        "Approx outside tolerance",
+			Matcher:     ensuring.Approx(1.5, 0.25),
+			Actual:      2,
+			Description: "within 0.25 of 1.5",
+			Explanation: "2 differs from 1.5 by 0.5, which is more than 0.25",
+		},
+		{
+			Name:        "Approx with non number",
+			Matcher:     ensuring.Approx(1.5, 0.25),
+			Actual:      "1.5",
+			Description: "within 0.25 of 1.5",
+			Explanation: "got type string, expected a number",
+		},
+		{
+			Name:        "Field with equal value",
+			Matcher:     ensuring.Field("Address.City", "Paris"),
+			Actual:      &exampleUser{Address: &exampleAddress{City: "Paris"}},
+			Description: `field Address.City equals "Paris"`,
+		},
+		{
+			Name:        "Field with matcher",
+			Matcher:     ensuring.Field("Name", ensuring.HasPrefix("M")),
+			Actual:      exampleUser{Name: "Mary"},
+			Description: `field Name has prefix "M"`,
+		},
+		{
+			Name:        "Field with different value",
+			Matcher:     ensuring.Field("Address.City", "Paris"),
+			Actual:      exampleUser{Address: &exampleAddress{City: "Rome"}},
+			Description: `field Address.City equals "Paris"`,
+			Explanation: `field Address.City: "Rome" != "Paris"`,
+		},
+		{
+			Name:        "Field with different struct",
+			Matcher:     ensuring.Field("Address", &exampleAddress{City: "Paris"}),
+			Actual:      exampleUser{Address: &exampleAddress{City: "Rome"}},
+			Description: "field Address equals &ensuring_test.exampleAddress{City:\"Paris\"}",
+			Explanation: "field Address: differs from expected:\n - City: \"Rome\" != \"Paris\"",
+		},
+		{
+			Name:        "Field through nil pointer",
+			Matcher:     ensuring.Field("Address.City", "Paris"),
+			Actual:      exampleUser{},
+			Description: `field Address.City equals "Paris"`,
+			Explanation: "cannot get field City from nil *ensuring_test.exampleAddress",
+		},
+		{
+			Name:        "Field that does not exist",
+			Matcher:     ensuring.Field("Email", "a@example.com"),
+			Actual:      exampleUser{},
+			Description: `field Email equals "a@example.com"`,
+			Explanation: "field Email does not exist on type ensuring_test.exampleUser",
+		},
+		{
+			Name:        "Field that is unexported",
+			Matcher:     ensuring.Field("secret", "abc"),
+			Actual:      exampleUser{secret: "abc"},
+			Description: `field secret equals "abc"`,
+			Explanation: "field secret on type ensuring_test.exampleUser is unexported",
+		},
+		{
+			Name:        "Field on non struct",
+			Matcher:     ensuring.Field("Name", "abc"),
+			Actual:      "abc",
+			Description: `field Name equals "abc"`,
+			Explanation: "cannot get field Name from type string, expected struct",
+		},
+		{
+			Name:        "Field on nil",
+			Matcher:     ensuring.Field("Name", "abc"),
+			Actual:      nil,
+			Description: `field Name equals "abc"`,
+			Explanation: "cannot get field Name from type nil, expected struct",
+		},
+		{
+			Name:        "AllOf when all match",
+			Matcher:     ensuring.AllOf(ensuring.HasPrefix("a"), ensuring.Len(3)),
+			Actual:      "abc",
+			Description: `all of (has prefix "a", has length 3)`,
+		},
+		{
+			Name:        "AllOf when some do not match",
+			Matcher:     ensuring.AllOf(ensuring.HasPrefix("x"), ensuring.Len(3), ensuring.Regex("z$")),
+			Actual:      "abc",
+			Description: `all of (has prefix "x", has length 3, matches regexp "z$")`,
+			Explanation: "\"abc\" does not have prefix \"x\"\n\"abc\" does not match regexp \"z$\"",
+		},
+		{
+			Name:        "AllOf when a matcher without an explanation does not match",
+			Matcher:     ensuring.AllOf(ensuring.HasPrefix("a"), unexplainedMatcher{}),
+			Actual:      "abc",
+			Description: `all of (has prefix "a", never matches)`,
+			Explanation: `"abc" does not match never matches`,
+		},
+		{
+			Name:        "AnyOf when one matches",
+			Matcher:     ensuring.AnyOf(ensuring.HasPrefix("x"), ensuring.HasPrefix("a")),
+			Actual:      "abc",
+			Description: `any of (has prefix "x", has prefix "a")`,
+		},
+		{
+			Name:        "AnyOf when none match",
+			Matcher:     ensuring.AnyOf(ensuring.HasPrefix("x"), ensuring.AllOf(ensuring.HasPrefix("y"), ensuring.Len(2))),
+			Actual:      "abc",
+			Description: `any of (has prefix "x", all of (has prefix "y", has length 2))`,
+			Explanation: "none of the matchers matched:\n" +
+				" - \"abc\" does not have prefix \"x\"\n" +
+				" - \"abc\" does not have prefix \"y\"\n" +
+				"   has length 3, expected 2",
+		},
+		{
+			Name:        "Not when the matcher does not match",
+			Matcher:     ensuring.Not(ensuring.HasPrefix("x")),
+			Actual:      "abc",
+			Description: `not (has prefix "x")`,
+		},
+		{
+			Name:        "Not when the matcher matches",
+			Matcher:     ensuring.Not(ensuring.HasPrefix("a")),
+			Actual:      "abc",
+			Description: `not (has prefix "a")`,
+			Explanation: `"abc" unexpectedly matches has prefix "a"`,
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+
+		ensure(entry.Matcher.String()).Equals(entry.Description)
+		ensure(entry.Matcher.Matches(entry.Actual)).Equals(entry.Explanation == "")
+
+		if entry.Explanation != "" {
+			ensure(entry.Matcher.Explain(entry.Actual)).Equals(entry.Explanation)
+		}
+	})
+}
+
+func TestRegexPanicsWithInvalidPattern(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	ensure(func() { ensuring.Regex(`(`) }).PanicsMatching("missing closing \\)")
+}
+
+func TestMatchersImplementGoMockMatcher(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	var matcher gomock.Matcher = ensuring.HasPrefix("ab")
+	ensure(matcher.Matches("abc")).IsTrue()
+	ensure(matcher.Matches("xyz")).IsFalse()
+}
+
+// unexplainedMatcher never matches, and does not explain why.
+type unexplainedMatcher struct{}
+
+func (unexplainedMatcher) Matches(actual interface{}) bool   { return false }
+func (unexplainedMatcher) Explain(actual interface{}) string { return "" }
+func (unexplainedMatcher) String() string                    { return "never matches" }
@@ -0,0 +1,123 @@
+package ensuring
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/kr/text"
+)
+
+// Soft runs fn with a scoped ensure instance whose assertion failures do not stop the test.
+// Instead, each failure is recorded, and once fn returns, all recorded failures are
+// reported together as a single failure.
+//
+// For example:
+//
+//	ensure.Soft(func(ensure ensuring.E) {
+//	  ensure(user.Name).Equals("John")
+//	  ensure(user.Email).Equals("john@example.com") // Checked, even if the Name check fails
+//	})
+//
+// Subtests started within fn (for example, using [E.Run]) are not soft.
+func (e E) Soft(fn func(ensure E)) {
+	c := e(nil)
+	c.t.Helper()
+	c.markRun()
+
+	st := &recordingT{T: c.t}
+
+	// The parent context is reused, so the same GoMock controller is shared with the soft scope.
+	fn(wrapWithContext(st, c.ctx, c.inSyncBubble))
+
+	failures := st.close()
+	if len(failures) == 0 {
+		return
+	}
+
+	formattedFailures := make([]string, 0, len(failures))
+	for i, failure := range failures {
+		formattedFailures = append(formattedFailures, fmt.Sprintf(
+			"FAILURE %d of %d:\n%s",
+			i+1,
+			len(failures),
+			text.Indent(strings.TrimPrefix(failure, "\n"), indent),
+		))
+	}
+
+	format, args := c.annotate("\nSoft assertions failed:\n\n%s", []interface{}{strings.Join(formattedFailures, "\n\n")})
+	c.t.Fatalf(format, args...)
+}
+
+// recordingT records failures while its scope is open, and passes everything else through to the parent T.
+// Once the scope is closed, failures are passed through to the parent T, since they can occur in cleanup functions.
+//
+// If exitOnFatal is true, Fatalf stops the scope by exiting its goroutine, similar to [testing.T.FailNow].
+type recordingT struct {
+	T
+
+	exitOnFatal bool
+
+	mu       sync.Mutex
+	failures []string
+	closed   bool
+}
+
+func (rt *recordingT) Errorf(format string, args ...interface{}) {
+	if rt.record(format, args) {
+		return
+	}
+
+	rt.T.Helper()
+	rt.T.Errorf(format, args...)
+}
+
+func (rt *recordingT) Fatalf(format string, args ...interface{}) {
+	if rt.record(format, args) {
+		if rt.exitOnFatal {
+			runtime.Goexit()
+		}
+
+		return
+	}
+
+	rt.T.Helper()
+	rt.T.Fatalf(format, args...)
+}
+
+func (rt *recordingT) unwrapT() T {
+	return rt.T
+}
+
+func (rt *recordingT) record(format string, args []interface{}) bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.closed {
+		return false
+	}
+
+	rt.failures = append(rt.failures, fmt.Sprintf(format, args...))
+	return true
+}
+
+func (rt *recordingT) close() []string {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.closed = true
+	return rt.failures
+}
+
+// unwrapT returns the T that was originally provided to ensure, if t wraps another T.
+func unwrapT(t T) T {
+	for {
+		wrapped, ok := t.(interface{ unwrapT() T }) //nolint:inamedparam
+		if !ok {
+			return t
+		}
+
+		t = wrapped.unwrapT()
+	}
+}
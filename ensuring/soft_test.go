@@ -0,0 +1,118 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/ensuring/internal/testhelper"
+	"go.uber.org/mock/gomock"
+)
+
+func TestESoft(t *testing.T) {
+	t.Run("when all assertions pass", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).Times(2)
+
+		ensure := ensure.New(mockT)
+		ensure.Soft(func(ensure ensuring.E) {
+			ensure(true).IsTrue()
+			ensure("abc").Equals("abc")
+		})
+	})
+
+	t.Run("when assertions fail", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup).Times(3)
+
+		mockT.EXPECT().Fatalf(
+			"\nSoft assertions failed:\n\n%s",
+			"FAILURE 1 of 2:\n"+
+				"  Got false, expected true\n"+
+				"\n"+
+				"FAILURE 2 of 2:\n"+
+				"  Actual string does not equal expected string:\n"+
+				"\n"+
+				"  ACTUAL:\n"+
+				"    \"abc\"\n"+
+				"\n"+
+				"  EXPECTED:\n"+
+				"    \"xyz\"",
+		)
+
+		afterFailures := false
+
+		ensure := ensure.New(mockT)
+		ensure.Soft(func(ensure ensuring.E) {
+			ensure(false).IsTrue()
+			ensure(true).IsTrue()
+			ensure("abc").Equals("xyz")
+
+			afterFailures = true
+		})
+
+		if !afterFailures {
+			t.Error("expected the soft scope to continue after failures")
+		}
+	})
+
+	t.Run("when failing directly", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(t.Cleanup)
+
+		mockT.EXPECT().Fatalf("\nSoft assertions failed:\n\n%s", "FAILURE 1 of 1:\n  something went wrong: 123")
+
+		ensure := ensure.New(mockT)
+		ensure.Soft(func(ensure ensuring.E) {
+			ensure.Failf("something went wrong: %d", 123)
+		})
+	})
+
+	t.Run("when chained assertion is missing", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+
+		var cleanupFn func()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(func(fn func()) {
+			cleanupFn = fn
+		})
+
+		ensure := ensure.New(mockT)
+		ensure.Soft(func(ensure ensuring.E) {
+			ensure(true)
+		})
+
+		// Reported directly, since the cleanup runs after the soft scope is closed
+		mockT.EXPECT().Errorf("Found ensure(<actual>) without chained assertion.")
+		cleanupFn()
+	})
+
+	t.Run("shares the GoMock controller", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().AnyTimes()
+		mockT.EXPECT().Cleanup(gomock.Any()).AnyTimes() // Setup by GoMock Controller and ensure
+
+		ensure := ensure.New(mockT)
+		outerController := ensure.GoMockController()
+
+		ensure.Soft(func(ensure ensuring.E) {
+			if ensure.GoMockController() != outerController {
+				t.Error("expected the GoMock controller to be shared with the soft scope")
+			}
+		})
+	})
+
+	t.Run("exposes the *testing.T instance", func(t *testing.T) {
+		testhelper.AllowAnyTestContexts(t)
+		ensure := ensure.New(t)
+
+		ensure.Soft(func(ensure ensuring.E) {
+			if ensure.T() != t {
+				t.Error("expected T() to return the *testing.T instance provided to ensure.New(t)")
+			}
+		})
+	})
+}
@@ -25,7 +25,7 @@ func (e E) RunSync(name string, fn func(ensure E)) {
 		syncable.Sync(func(ctx testctx.Context) {
 			t := ctx.T()
 			t.Helper()
-			ensure := wrap(t)
+			ensure := wrapWithContext(t, newTestContext(t), true)
 			fn(ensure)
 		})
 	})
@@ -56,7 +56,7 @@ func (e E) RunTableByIndexSync(table interface{}, fn func(ensure E, i int)) {
 		syncable.Sync(func(ctx testctx.Context) {
 			t := ctx.T()
 			t.Helper()
-			ensure := wrap(t)
+			ensure := wrapWithContext(t, newTestContext(t), true)
 			fn(ensure, i)
 		})
 	})
@@ -4,8 +4,12 @@ package ensuring_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/JosiahWitt/ensure"
 	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/ensuring/internal/testhelper"
+	"github.com/JosiahWitt/ensure/internal/mocks/mock_testctx"
 )
 
 func TestERunSync(t *testing.T) {
@@ -25,3 +29,134 @@ func TestERunTableByIndexSync(t *testing.T) {
 		},
 	}.test(t)
 }
+
+func TestChainPanicsInRunTableByIndexSync(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name string
+	}{
+		{Name: "first"},
+		{Name: "second"},
+	}
+
+	ensure.RunTableByIndexSync(table, func(ensure ensuring.E, i int) {
+		mockT := mock_testctx.NewMockT(ensure.GoMockController())
+		mockT.EXPECT().Helper()
+
+		ensure(func() {
+			mockT.Helper()
+			time.Sleep(time.Hour)
+			panic("oops")
+		}).PanicsWith("oops")
+
+		ensure(func() { time.Sleep(time.Hour) }).DoesNotPanic()
+	})
+}
+
+func TestEEventuallyInRunSync(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	ensure.RunSync("uses the fake clock", func(ensure ensuring.E) {
+		start := time.Now()
+		ready := make(chan struct{})
+
+		go func() {
+			time.Sleep(time.Hour - time.Second)
+			close(ready)
+		}()
+
+		ensure.Eventually(2*time.Hour, time.Minute, func(ensure ensuring.E) {
+			select {
+			case <-ready:
+			default:
+				ensure.Failf("not ready")
+			}
+		})
+
+		ensure(time.Since(start)).Equals(time.Hour)
+	})
+}
+
+func TestEEventuallyInRunSyncWhenAttemptBlocks(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	ensure.RunSync("waits for an attempt that sleeps past the timeout", func(ensure ensuring.E) {
+		start := time.Now()
+
+		ensure.Eventually(time.Minute, time.Second, func(ensure ensuring.E) {
+			time.Sleep(time.Hour)
+		})
+
+		ensure(time.Since(start)).Equals(time.Hour)
+	})
+
+	ensure.RunSync("waits for an attempt that is blocked past the timeout", func(ensure ensuring.E) {
+		start := time.Now()
+
+		block := make(chan struct{})
+		go func() {
+			time.Sleep(time.Hour)
+			close(block)
+		}()
+
+		attempts := 0
+		ensure.Eventually(time.Minute, time.Second, func(ensure ensuring.E) {
+			attempts++
+			<-block
+		})
+
+		ensure(attempts).Equals(1)
+		ensure(time.Since(start)).Equals(time.Hour)
+	})
+}
+
+func TestEConsistentlyInRunTableByIndexSync(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name string
+	}{
+		{Name: "first"},
+		{Name: "second"},
+	}
+
+	ensure.RunTableByIndexSync(table, func(ensure ensuring.E, i int) {
+		start := time.Now()
+		attempts := 0
+
+		ensure.Consistently(time.Hour, time.Minute, func(ensure ensuring.E) {
+			attempts++
+			ensure(attempts <= 61).IsTrue()
+		})
+
+		ensure(attempts).Equals(61)
+		ensure(time.Since(start)).Equals(time.Hour)
+	})
+}
+
+func TestChainChannelsInRunSync(t *testing.T) {
+	testhelper.AllowAnyTestContexts(t)
+	ensure := ensure.New(t)
+
+	ensure.RunSync("uses the fake clock", func(ensure ensuring.E) {
+		start := time.Now()
+		ch := make(chan int)
+
+		go func() {
+			for i := 1; i <= 3; i++ {
+				time.Sleep(time.Minute)
+				ch <- i
+			}
+		}()
+
+		ensure(ch).BlocksFor(time.Minute - time.Second)
+		ensure(ch).ReceivesEqual(1, time.Hour)
+		ensure(ch).EmitsSequence([]int{2, 3}, 3*time.Minute)
+		ensure(time.Since(start)).Equals(3 * time.Minute)
+	})
+}
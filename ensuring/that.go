@@ -0,0 +1,94 @@
+package ensuring
+
+import "strings"
+
+// TypedChain chains assertions to a value of type T, so the expected values are checked at compile time.
+// Use [That] to create a TypedChain.
+type TypedChain[T any] struct {
+	c *Chain
+}
+
+// That ensures the actual value is correct, using assertions that are checked at compile time.
+// Like ensure(actual), an assertion must be chained, otherwise the test fails.
+//
+// For example:
+//
+//	ensuring.That(ensure, int64(3)).Equals(3) // The untyped constant is an int64
+//	ensuring.That(ensure, int64(3)).Equals(int32(3)) // Does not compile
+func That[T any](ensure E, actual T) *TypedChain[T] {
+	return &TypedChain[T]{c: ensure(actual)}
+}
+
+// Not negates the next assertion in the chain. See [Chain.Not] for more info.
+func (tc *TypedChain[T]) Not() *TypedChain[T] {
+	tc.c.Not()
+	return tc
+}
+
+// Equals ensures the actual value equals the expected value. See [Chain.Equals] for more info.
+func (tc *TypedChain[T]) Equals(expected T, opts ...EqualsOption) {
+	tc.c.t.Helper()
+	tc.c.Equals(expected, opts...)
+}
+
+// IsOneOf ensures the actual value equals at least one of the options.
+// Values are compared the same way as [Chain.Equals].
+//
+// For example:
+//
+//	ensuring.That(ensure, status).IsOneOf("pending", "running")
+func (tc *TypedChain[T]) IsOneOf(options ...T) {
+	c := tc.c
+	c.t.Helper()
+	c.markRun()
+	defer c.assertion("be one of", options)()
+
+	if len(options) == 0 {
+		c.invalid("Expected at least one option to be provided to IsOneOf")
+		return
+	}
+
+	formattedOptions := make([]string, 0, len(options))
+	for _, option := range options {
+		if len(checkEquality(c.actual, option, nil)) == 0 {
+			return
+		}
+
+		formattedOptions = append(formattedOptions, prettyFormat(option))
+	}
+
+	c.fail(
+		"\nActual does not equal any of the options:\n\nACTUAL:\n%s\n\nOPTIONS:\n%s",
+		prettyFormat(c.actual),
+		strings.Join(formattedOptions, "\n"),
+	)
+}
+
+// SliceChain chains assertions to a slice with elements of type T, so the expected values are checked at
+// compile time. Use [ThatSlice] to create a SliceChain.
+type SliceChain[T any] struct {
+	TypedChain[[]T]
+}
+
+// ThatSlice ensures the actual slice is correct, using assertions that are checked at compile time.
+// It supports the same assertions as [That], along with assertions on the elements.
+//
+// For example:
+//
+//	ensuring.ThatSlice(ensure, []string{"abc", "xyz"}).Contains("xyz")
+//	ensuring.ThatSlice(ensure, []string{"abc", "xyz"}).Contains(123) // Does not compile
+func ThatSlice[T any](ensure E, actual []T) *SliceChain[T] {
+	return &SliceChain[T]{TypedChain: TypedChain[[]T]{c: ensure(actual)}}
+}
+
+// Not negates the next assertion in the chain. See [Chain.Not] for more info.
+func (sc *SliceChain[T]) Not() *SliceChain[T] {
+	sc.c.Not()
+	return sc
+}
+
+// Contains ensures the actual slice contains the expected element. See [Chain.Contains] for more info.
+func (sc *SliceChain[T]) Contains(elem T) {
+	sc.c.t.Helper()
+	sc.c.Contains(elem)
+}
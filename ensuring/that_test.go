@@ -0,0 +1,155 @@
+package ensuring_test
+
+import (
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"go.uber.org/mock/gomock"
+)
+
+func TestThat(t *testing.T) {
+	t.Run("when equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, int64(3)).Equals(3)
+	})
+
+	t.Run("when not equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("\n%s\n\nACTUAL:\n%s\n\nEXPECTED:\n%s",
+			"Actual does not equal expected:\n - Name: \"John\" != \"Sam\"",
+			ExamplePerson{Name: "John"}.ExpectedOutput(),
+			ExamplePerson{Name: "Sam"}.ExpectedOutput(),
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, ExamplePerson{Name: "John"}).Equals(ExamplePerson{Name: "Sam"})
+	})
+
+	t.Run("when equal with options", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, ExamplePerson{Name: "John", Email: "john@test"}).
+			Equals(ExamplePerson{Name: "John"}, ensuring.IgnoreFields("Email"))
+	})
+
+	t.Run("when negated and not equal", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(3)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, "abc").Not().Equals("xyz")
+	})
+
+	t.Run("when chained assertion is missing", func(t *testing.T) {
+		mockT := setupMockT(t)
+		mockT.EXPECT().Helper().AnyTimes()
+
+		var cleanupFn func()
+		mockT.EXPECT().Cleanup(gomock.Any()).Do(func(fn func()) {
+			cleanupFn = fn
+		})
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, 123)
+
+		mockT.EXPECT().Errorf("Found ensure(<actual>) without chained assertion.")
+		cleanupFn()
+	})
+}
+
+func TestTypedChainIsOneOf(t *testing.T) {
+	t.Run("when equal to an option", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper()
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, "running").IsOneOf("pending", "running")
+	})
+
+	t.Run("when not equal to any option", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual does not equal any of the options:\n\nACTUAL:\n%s\n\nOPTIONS:\n%s",
+			`  "done"`,
+			"  \"pending\"\n  \"running\"",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, "done").IsOneOf("pending", "running")
+	})
+
+	t.Run("when no options are provided", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf("Expected at least one option to be provided to IsOneOf").After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, "done").IsOneOf()
+	})
+
+	t.Run("when negated and equal to an option", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"\nActual was expected NOT to %s:\n%s\n\nACTUAL:\n%s",
+			"be one of",
+			"  []int{1, 2}",
+			"  int(2)",
+		).After(
+			mockT.EXPECT().Helper().Times(2),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.That(ensure, 2).Not().IsOneOf(1, 2)
+	})
+}
+
+func TestThatSlice(t *testing.T) {
+	t.Run("when contains element", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensuring.ThatSlice(ensure, []string{"abc", "xyz"}).Contains("xyz")
+	})
+
+	t.Run("when does not contain element", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Fatalf(
+			"Actual does not contain expected:\n\nACTUAL:\n%s\n\nEXPECTED TO CONTAIN:\n%s",
+			`  []string{"abc", "xyz"}`,
+			`  "qwerty"`,
+		).After(
+			mockT.EXPECT().Helper().Times(3),
+		)
+
+		ensure := ensure.New(mockT)
+		ensuring.ThatSlice(ensure, []string{"abc", "xyz"}).Contains("qwerty")
+	})
+
+	t.Run("when negated and does not contain element", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(3)
+
+		ensure := ensure.New(mockT)
+		ensuring.ThatSlice(ensure, []int{1, 2}).Not().Contains(3)
+	})
+
+	t.Run("supports typed chain assertions", func(t *testing.T) {
+		mockT := setupMockTWithCleanupCheck(t)
+		mockT.EXPECT().Helper().Times(2)
+
+		ensure := ensure.New(mockT)
+		ensuring.ThatSlice(ensure, []int{1, 2}).Equals([]int{1, 2})
+	})
+}
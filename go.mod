@@ -4,7 +4,6 @@ go 1.23.0
 
 require (
 	github.com/JosiahWitt/erk v0.5.11
-	github.com/go-test/deep v1.1.1
 	github.com/kr/pretty v0.3.1
 	github.com/kr/text v0.2.0
 	go.uber.org/mock v0.6.0
@@ -0,0 +1,590 @@
+// Package diff compares values using reflection, and reports the differences between them.
+//
+// Unlike [reflect.DeepEqual], the comparison can be customized for each call using [Options].
+// No global state is used, so it is safe to compare values concurrently.
+package diff
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kr/pretty"
+	"github.com/kr/text"
+)
+
+const (
+	floatFormat = "%.10f"
+	indent      = "  "
+
+	nilValue       = "nil"
+	missingKey     = "<missing key>"
+	missingElement = "<missing element>"
+)
+
+//nolint:gochecknoglobals // Only read internally.
+var (
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Options customize how values are compared.
+type Options struct {
+	// IgnoredFields contains the names of struct fields to skip, at any depth.
+	IgnoredFields []string
+
+	// IgnoreUnexported skips unexported struct fields.
+	IgnoreUnexported bool
+
+	// NilEqualsEmpty treats nil slices and maps as equal to empty slices and maps.
+	NilEqualsEmpty bool
+
+	// CompareTimesWithEqual compares [time.Time] values using [time.Time.Equal], including unexported struct fields.
+	// Exported values already use the Equal method, but unexported fields are otherwise compared field by field.
+	// Differences in unexported times are reported in UTC, since their locations cannot be read.
+	CompareTimesWithEqual bool
+
+	// CompareReferences compares channels and unsafe pointers by identity, and functions by whether they are nil.
+	// By default, they are skipped, since they usually hold references that cannot be compared meaningfully.
+	CompareReferences bool
+
+	// ApproxFloats compares floats as equal if they are within FloatEpsilon of each other.
+	// By default, floats are compared to 10 decimal places.
+	ApproxFloats bool
+	FloatEpsilon float64
+}
+
+// Difference describes a single difference between two values.
+type Difference struct {
+	// Path to the difference, for example: Orders[3].Items["sku"].Price.
+	// It is empty when the difference is at the root of the values.
+	Path string
+
+	// Actual and Expected contain the formatted values that differ.
+	Actual   string
+	Expected string
+}
+
+// String formats the difference on a single line if possible, otherwise the values are formatted in indented blocks.
+func (d Difference) String() string {
+	if !strings.Contains(d.Actual, "\n") && !strings.Contains(d.Expected, "\n") {
+		if d.Path == "" {
+			return d.Actual + " != " + d.Expected
+		}
+
+		return d.Path + ": " + d.Actual + " != " + d.Expected
+	}
+
+	block := "ACTUAL:\n" + text.Indent(d.Actual, indent) + "\nEXPECTED:\n" + text.Indent(d.Expected, indent)
+	if d.Path == "" {
+		return block
+	}
+
+	return d.Path + ":\n" + text.Indent(block, indent)
+}
+
+// Compare returns the differences between actual and expected, with maps compared in sorted key order.
+// If no differences are returned, the values are equal.
+//
+// Cycles are detected, so recursive values are supported. Pointers that alias each other are equal.
+// Channels, functions, and unsafe pointers are skipped unless [Options.CompareReferences] is set.
+func Compare(actual, expected interface{}, opts Options) []Difference {
+	c := &comparer{
+		opts:    opts,
+		visited: make(map[visit]struct{}),
+	}
+
+	c.compare(reflect.ValueOf(actual), reflect.ValueOf(expected))
+	return c.diffs
+}
+
+type comparer struct {
+	opts    Options
+	path    []string
+	diffs   []Difference
+	visited map[visit]struct{}
+
+	// partial is set by [ComparePartial], and skipped contains the paths it did not compare.
+	partial bool
+	skipped []string
+}
+
+// visit is used to detect cycles, and is the same approach used by [reflect.DeepEqual].
+type visit struct {
+	a   uintptr
+	b   uintptr
+	typ reflect.Type
+}
+
+//nolint:cyclop,funlen,gocognit,gocyclo // It seems clearer as one larger method
+func (c *comparer) compare(a, b reflect.Value) {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			c.saveDiff(formatSummary(a), formatSummary(b))
+		}
+
+		return
+	}
+
+	aType := a.Type()
+	bType := b.Type()
+	if aType != bType {
+		c.saveDiff(formatTypedValue(a), formatTypedValue(b))
+		return
+	}
+
+	if c.opts.CompareTimesWithEqual && aType == timeType && c.compareTimes(a, b) {
+		return
+	}
+
+	kind := a.Kind()
+	hasElem := kind == reflect.Ptr || kind == reflect.Interface
+
+	// Errors are compared by their messages, which must happen before dereferencing
+	// pointers, since most errors are pointers to structs.
+	if aType.Implements(errorType) && (!hasElem || (!a.IsNil() && !b.IsNil())) && a.CanInterface() && b.CanInterface() {
+		aMessage := a.Interface().(error).Error() //nolint:forcetypeassert // Checked by Implements
+		bMessage := b.Interface().(error).Error() //nolint:forcetypeassert // Checked by Implements
+		if aMessage != bMessage {
+			c.saveDiff(strconv.Quote(aMessage), strconv.Quote(bMessage))
+		}
+
+		return
+	}
+
+	if c.isVisited(a, b) {
+		return
+	}
+
+	//nolint:exhaustive // Remaining kinds are not compared
+	switch kind {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				c.saveDiff(formatValue(a), formatValue(b))
+			}
+
+			return
+		}
+
+		c.compare(a.Elem(), b.Elem())
+
+	case reflect.Struct:
+		if c.compareWithEqualMethod(a, b) {
+			return
+		}
+
+		for i := range a.NumField() {
+			field := aType.Field(i)
+			if c.isIgnoredField(field) {
+				continue
+			}
+
+			c.pushField(field.Name)
+			if c.partial && b.Field(i).IsZero() {
+				c.saveSkipped()
+			} else {
+				c.compare(a.Field(i), b.Field(i))
+			}
+			c.pop()
+		}
+
+	case reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			c.compareNil(a, b)
+			return
+		}
+
+		for _, key := range sortedKeys(a, b) {
+			c.pushIndex(formatValue(key))
+
+			aValue := a.MapIndex(key)
+			bValue := b.MapIndex(key)
+
+			switch {
+			case !bValue.IsValid() && c.partial:
+				c.saveSkipped()
+			case !bValue.IsValid():
+				c.saveDiff(formatValue(aValue), missingKey)
+			case !aValue.IsValid():
+				c.saveDiff(missingKey, formatValue(bValue))
+			default:
+				c.compare(aValue, bValue)
+			}
+
+			c.pop()
+		}
+
+	case reflect.Array:
+		for i := range a.Len() {
+			c.pushIndex(strconv.Itoa(i))
+			c.compare(a.Index(i), b.Index(i))
+			c.pop()
+		}
+
+	case reflect.Slice:
+		if a.IsNil() || b.IsNil() {
+			c.compareNil(a, b)
+			return
+		}
+
+		for i := range max(a.Len(), b.Len()) {
+			c.pushIndex(strconv.Itoa(i))
+
+			switch {
+			case i >= b.Len():
+				c.saveDiff(formatValue(a.Index(i)), missingElement)
+			case i >= a.Len():
+				c.saveDiff(missingElement, formatValue(b.Index(i)))
+			default:
+				c.compare(a.Index(i), b.Index(i))
+			}
+
+			c.pop()
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if !c.floatsEqual(a.Float(), b.Float()) {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.Complex64, reflect.Complex128:
+		if a.Complex() != b.Complex() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.Bool:
+		if a.Bool() != b.Bool() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.Int() != b.Int() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if a.Uint() != b.Uint() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.String:
+		if a.String() != b.String() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.Chan, reflect.UnsafePointer:
+		if c.opts.CompareReferences && a.Pointer() != b.Pointer() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+
+	case reflect.Func:
+		// Functions cannot be compared, so they are only different if one of them is nil
+		if c.opts.CompareReferences && a.IsNil() != b.IsNil() {
+			c.saveDiff(formatValue(a), formatValue(b))
+		}
+	}
+}
+
+// isVisited returns true if the pointers backing a and b alias each other, or if they were already compared.
+// Otherwise, it marks them as visited. Checking both values that were visited supports cyclic values.
+func (c *comparer) isVisited(a, b reflect.Value) bool {
+	//nolint:exhaustive // Only kinds that can be cyclic are checked
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			return false
+		}
+	case reflect.Slice:
+		if a.IsNil() || b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+	default:
+		return false
+	}
+
+	aPointer := a.Pointer()
+	bPointer := b.Pointer()
+	if aPointer == bPointer {
+		return true
+	}
+
+	v := visit{a: aPointer, b: bPointer, typ: a.Type()}
+	if _, ok := c.visited[v]; ok {
+		return true
+	}
+
+	c.visited[v] = struct{}{}
+	return false
+}
+
+// compareWithEqualMethod uses an Equal method (like [time.Time.Equal]) to compare the values, if it exists.
+// It returns true if the method was used.
+func (c *comparer) compareWithEqualMethod(a, b reflect.Value) bool {
+	if !a.CanInterface() {
+		return false
+	}
+
+	equal := a.MethodByName("Equal")
+	if !equal.IsValid() {
+		return false
+	}
+
+	// Methods promoted from embedded structs expect a different type, so they are skipped
+	equalType := equal.Type()
+	if equalType.NumIn() != 1 || equalType.In(0) != b.Type() || equalType.NumOut() != 1 || equalType.Out(0).Kind() != reflect.Bool {
+		return false
+	}
+
+	if !equal.Call([]reflect.Value{b})[0].Bool() {
+		c.saveDiff(formatValue(a), formatValue(b))
+	}
+
+	return true
+}
+
+// compareTimes compares time.Time values using [time.Time.Equal], returning true if it was able to read both values.
+func (c *comparer) compareTimes(a, b reflect.Value) bool {
+	aTime, aOK := timeOf(a)
+	bTime, bOK := timeOf(b)
+	if !aOK || !bOK {
+		return false
+	}
+
+	if !aTime.Equal(bTime) {
+		c.saveDiff(formatValue(reflect.ValueOf(aTime)), formatValue(reflect.ValueOf(bTime)))
+	}
+
+	return true
+}
+
+// timeOf returns the time.Time in v. Times read from unexported fields cannot be converted to an interface, so
+// they are rebuilt in UTC from their wall and ext fields, which are read the same way as [time.Time] does.
+func timeOf(v reflect.Value) (time.Time, bool) {
+	if v.CanInterface() {
+		return v.Interface().(time.Time), true //nolint:forcetypeassert // Checked by the caller
+	}
+
+	wallField := v.FieldByName("wall")
+	extField := v.FieldByName("ext")
+	if wallField.Kind() != reflect.Uint64 || extField.Kind() != reflect.Int64 {
+		return time.Time{}, false
+	}
+
+	const (
+		hasMonotonic  = 1 << 63
+		nsecMask      = 1<<30 - 1
+		nsecShift     = 30
+		secondsPerDay = 24 * 60 * 60
+
+		// Seconds from January 1 of year 1 to January 1, 1885 and January 1, 1970
+		wallToInternal int64 = (1884*365 + 1884/4 - 1884/100 + 1884/400) * secondsPerDay
+		unixToInternal int64 = (1969*365 + 1969/4 - 1969/100 + 1969/400) * secondsPerDay
+	)
+
+	wall := wallField.Uint()
+	sec := extField.Int()
+	if wall&hasMonotonic != 0 {
+		// The seconds since 1885 are stored in wall, and ext contains the monotonic clock reading
+		sec = wallToInternal + int64(wall<<1>>(nsecShift+1)) //nolint:gosec // Only 33 bits are used
+	}
+
+	return time.Unix(sec-unixToInternal, int64(wall&nsecMask)).UTC(), true //nolint:gosec // Only 30 bits are used
+}
+
+func (c *comparer) floatsEqual(a, b float64) bool {
+	if !c.opts.ApproxFloats {
+		return fmt.Sprintf(floatFormat, a) == fmt.Sprintf(floatFormat, b)
+	}
+
+	// Checking equality first supports infinities, and NaNs are equal to each other, like the default comparison
+	return a == b || (math.IsNaN(a) && math.IsNaN(b)) || math.Abs(a-b) <= c.opts.FloatEpsilon
+}
+
+func (c *comparer) compareNil(a, b reflect.Value) {
+	if c.opts.NilEqualsEmpty && a.Len() == 0 && b.Len() == 0 {
+		return
+	}
+
+	if a.IsNil() != b.IsNil() {
+		c.saveDiff(formatValue(a), formatValue(b))
+	}
+}
+
+func (c *comparer) isIgnoredField(field reflect.StructField) bool {
+	if c.opts.IgnoreUnexported && !field.IsExported() {
+		return true
+	}
+
+	for _, ignoredField := range c.opts.IgnoredFields {
+		if field.Name == ignoredField {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *comparer) pushField(name string) {
+	if len(c.path) > 0 {
+		name = "." + name
+	}
+
+	c.path = append(c.path, name)
+}
+
+func (c *comparer) pushIndex(index string) {
+	c.path = append(c.path, "["+index+"]")
+}
+
+func (c *comparer) pop() {
+	c.path = c.path[:len(c.path)-1]
+}
+
+func (c *comparer) saveDiff(actual, expected string) {
+	c.diffs = append(c.diffs, Difference{
+		Path:     strings.Join(c.path, ""),
+		Actual:   actual,
+		Expected: expected,
+	})
+}
+
+func (c *comparer) saveSkipped() {
+	c.skipped = append(c.skipped, strings.Join(c.path, ""))
+}
+
+// sortedKeys returns the union of the keys in both maps, in a stable order.
+func sortedKeys(a, b reflect.Value) []reflect.Value {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+
+	sortKeys(keys)
+	return keys
+}
+
+// SortedMapKeys returns the keys of the map m, sorted in the same order they are compared.
+func SortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sortKeys(keys)
+	return keys
+}
+
+func sortKeys(keys []reflect.Value) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessKey(keys[i], keys[j])
+	})
+}
+
+func lessKey(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+
+	if a.IsValid() && b.IsValid() && a.Type() == b.Type() {
+		//nolint:exhaustive // Remaining kinds are sorted by their formatted values
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		}
+	}
+
+	return formatTypedValue(a) < formatTypedValue(b)
+}
+
+// FormatValue formats the value for use in a [Difference].
+func FormatValue(value interface{}) string {
+	return formatValue(reflect.ValueOf(value))
+}
+
+//nolint:cyclop // It seems clearer as one larger function
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return nilValue
+	}
+
+	//nolint:exhaustive // Remaining kinds are pretty printed
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Bool, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return fmt.Sprint(v)
+	case reflect.Interface:
+		if v.IsNil() {
+			return formatNil(v)
+		}
+
+		// Format the underlying value, so it is formatted the same way as when it is not in an interface
+		return formatValue(v.Elem())
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		if v.IsNil() {
+			return formatNil(v)
+		}
+	case reflect.Struct:
+		// Structs like time.Time are easier to read using their String method
+		if v.CanInterface() && v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String() //nolint:forcetypeassert // Checked by Implements
+		}
+	}
+
+	if !v.CanInterface() {
+		return fmt.Sprintf("%+v", v)
+	}
+
+	return pretty.Sprint(v.Interface())
+}
+
+// formatSummary formats composite values using only their type, so they are not repeated in full.
+// It is used when the other value is nil, since the difference is obvious.
+func formatSummary(v reflect.Value) string {
+	formatted := formatValue(v)
+	if !strings.Contains(formatted, "\n") {
+		return formatted
+	}
+
+	return v.Type().String() + "{...}"
+}
+
+func formatNil(v reflect.Value) string {
+	if v.Kind() == reflect.Interface {
+		return nilValue
+	}
+
+	return v.Type().String() + "(nil)"
+}
+
+func formatTypedValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return nilValue
+	}
+
+	formatted := formatValue(v)
+	typeName := v.Type().String()
+	if strings.HasPrefix(formatted, typeName) {
+		return formatted
+	}
+
+	return typeName + "(" + formatted + ")"
+}
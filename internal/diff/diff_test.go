@@ -0,0 +1,448 @@
+package diff_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+type Person struct {
+	Name      string
+	Age       int
+	UpdatedAt time.Time
+	Tags      []string
+	Meta      map[string]string
+	Friend    *Person
+
+	secret string
+}
+
+type Order struct {
+	ID    int
+	Items map[string]Item
+}
+
+type Item struct {
+	Price float64
+}
+
+type Customer struct {
+	Orders []Order
+}
+
+type Event struct {
+	at      time.Time
+	history []time.Time
+	byName  map[string]interface{}
+}
+
+type Node struct {
+	Value int
+	Next  *Node
+}
+
+type References struct {
+	Chan    chan int
+	Func    func()
+	Pointer unsafe.Pointer
+	value   reflect.Value
+}
+
+func TestCompare(t *testing.T) {
+	ensure := ensure.New(t)
+
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	monotonic := time.Now()
+
+	cyclicA := &Node{Value: 1}
+	cyclicA.Next = cyclicA
+
+	cyclicB := &Node{Value: 1}
+	cyclicB.Next = cyclicB
+
+	cyclicC := &Node{Value: 2}
+	cyclicC.Next = cyclicC
+
+	shared := &Person{Name: "Shared"}
+
+	chanA := make(chan int)
+	chanB := make(chan int)
+	fn := func() {}
+
+	x, y := 1, 1
+	pointerX := unsafe.Pointer(&x)
+	pointerY := unsafe.Pointer(&y)
+
+	manyActual := make([]int, 15)
+	manyExpected := make([]int, 15)
+	manyDiffs := []string{}
+	for i := range manyExpected {
+		manyExpected[i] = i + 1
+		manyDiffs = append(manyDiffs, fmt.Sprintf("[%d]: 0 != %d", i, i+1))
+	}
+
+	table := []struct {
+		Name     string
+		Actual   interface{}
+		Expected interface{}
+		Options  diff.Options
+		Diffs    []string
+	}{
+		{
+			Name:     "both nil",
+			Actual:   nil,
+			Expected: nil,
+		},
+		{
+			Name:     "actual nil",
+			Actual:   nil,
+			Expected: 1,
+			Diffs:    []string{"nil != 1"},
+		},
+		{
+			Name:     "expected nil",
+			Actual:   "abc",
+			Expected: nil,
+			Diffs:    []string{`"abc" != nil`},
+		},
+		{
+			Name:     "expected nil with composite actual",
+			Actual:   Person{Name: "John"},
+			Expected: nil,
+			Diffs:    []string{"diff_test.Person{...} != nil"},
+		},
+		{
+			Name:     "different types",
+			Actual:   int64(1),
+			Expected: 1,
+			Diffs:    []string{"int64(1) != int(1)"},
+		},
+		{
+			Name:     "equal structs",
+			Actual:   Person{Name: "John", Age: 20, UpdatedAt: now, secret: "abc"},
+			Expected: Person{Name: "John", Age: 20, UpdatedAt: now, secret: "abc"},
+		},
+		{
+			Name:     "times in different locations are compared with Equal",
+			Actual:   Person{UpdatedAt: now},
+			Expected: Person{UpdatedAt: now.In(time.FixedZone("UTC-1", -60*60))},
+		},
+		{
+			Name:     "times that are not equal",
+			Actual:   Person{UpdatedAt: now},
+			Expected: Person{UpdatedAt: now.Add(time.Second)},
+			Diffs:    []string{"UpdatedAt: 2020-01-02 03:04:05 +0000 UTC != 2020-01-02 03:04:06 +0000 UTC"},
+		},
+		{
+			Name:     "different fields",
+			Actual:   Person{Name: "John", Age: 20, secret: "abc"},
+			Expected: Person{Name: "Sam", Age: 21, secret: "xyz"},
+			Diffs:    []string{`Name: "John" != "Sam"`, "Age: 20 != 21", `secret: "abc" != "xyz"`},
+		},
+		{
+			Name:     "nested pointers",
+			Actual:   &Person{Friend: &Person{Name: "John"}},
+			Expected: &Person{Friend: &Person{Name: "Sam"}},
+			Diffs:    []string{`Friend.Name: "John" != "Sam"`},
+		},
+		{
+			Name:     "nil pointer field",
+			Actual:   &Person{Friend: &Person{Name: "John"}},
+			Expected: &Person{},
+			Diffs: []string{
+				"Friend:\n" +
+					"  ACTUAL:\n" +
+					"    &diff_test.Person{\n" +
+					"        Name:      \"John\",\n" +
+					"        Age:       0,\n" +
+					"        UpdatedAt: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),\n" +
+					"        Tags:      nil,\n" +
+					"        Meta:      {},\n" +
+					"        Friend:    (*diff_test.Person)(nil),\n" +
+					"        secret:    \"\",\n" +
+					"    }\n" +
+					"  EXPECTED:\n" +
+					"    *diff_test.Person(nil)",
+			},
+		},
+		{
+			Name: "full paths",
+			Actual: Customer{Orders: []Order{
+				{ID: 1, Items: map[string]Item{"sku": {Price: 1}}},
+				{ID: 2, Items: map[string]Item{"sku": {Price: 2}, "other": {Price: 3}}},
+			}},
+			Expected: Customer{Orders: []Order{
+				{ID: 1, Items: map[string]Item{"sku": {Price: 1}}},
+				{ID: 2, Items: map[string]Item{"sku": {Price: 2.5}, "new": {Price: 4}}},
+			}},
+			Diffs: []string{
+				`Orders[1].Items["new"]: <missing key> != diff_test.Item{Price:4}`,
+				`Orders[1].Items["other"]: diff_test.Item{Price:3} != <missing key>`,
+				`Orders[1].Items["sku"].Price: 2 != 2.5`,
+			},
+		},
+		{
+			Name:     "slices",
+			Actual:   []string{"a", "b", "c"},
+			Expected: []string{"a", "x"},
+			Diffs:    []string{`[1]: "b" != "x"`, `[2]: "c" != <missing element>`},
+		},
+		{
+			Name:     "arrays",
+			Actual:   [2]int{1, 2},
+			Expected: [2]int{1, 3},
+			Diffs:    []string{"[1]: 2 != 3"},
+		},
+		{
+			Name:     "maps are compared in sorted key order",
+			Actual:   map[int]string{3: "c", 1: "a", 10: "j", 2: "b"},
+			Expected: map[int]string{3: "x", 1: "x", 10: "x", 2: "x"},
+			Diffs:    []string{`[1]: "a" != "x"`, `[2]: "b" != "x"`, `[3]: "c" != "x"`, `[10]: "j" != "x"`},
+		},
+		{
+			Name:     "values in interfaces are formatted like their underlying values",
+			Actual:   map[string]interface{}{"a": "abc", "b": true},
+			Expected: map[string]interface{}{"c": 1},
+			Diffs:    []string{`["a"]: "abc" != <missing key>`, `["b"]: true != <missing key>`, `["c"]: <missing key> != 1`},
+		},
+		{
+			Name:     "all differences are reported",
+			Actual:   manyActual,
+			Expected: manyExpected,
+			Diffs:    manyDiffs,
+		},
+		{
+			Name:     "errors are compared by message",
+			Actual:   errors.New("abc"),
+			Expected: errors.New("xyz"),
+			Diffs:    []string{`"abc" != "xyz"`},
+		},
+		{
+			Name:     "equal cyclic values",
+			Actual:   cyclicA,
+			Expected: cyclicB,
+		},
+		{
+			Name:     "different cyclic values",
+			Actual:   cyclicA,
+			Expected: cyclicC,
+			Diffs:    []string{"Value: 1 != 2"},
+		},
+		{
+			Name:     "aliased pointers",
+			Actual:   []*Person{shared, shared},
+			Expected: []*Person{shared, {Name: "Shared"}},
+		},
+		{
+			Name:     "channels, funcs, and unsafe pointers are skipped by default",
+			Actual:   References{Chan: chanA, Func: fn, Pointer: pointerX},
+			Expected: References{Chan: chanB, Func: nil, Pointer: pointerY},
+		},
+		{
+			Name:     "unexported reflect values holding equal values are equal by default",
+			Actual:   References{value: reflect.ValueOf(&x)},
+			Expected: References{value: reflect.ValueOf(&y)},
+		},
+		{
+			Name:     "with CompareReferences: same channels",
+			Actual:   []chan int{chanA},
+			Expected: []chan int{chanA},
+			Options:  diff.Options{CompareReferences: true},
+		},
+		{
+			Name:     "with CompareReferences: different channels",
+			Actual:   []chan int{chanA},
+			Expected: []chan int{chanB},
+			Options:  diff.Options{CompareReferences: true},
+			Diffs:    []string{"[0]: " + diff.FormatValue(chanA) + " != " + diff.FormatValue(chanB)},
+		},
+		{
+			Name:     "with CompareReferences: nil channel",
+			Actual:   []chan int{chanA},
+			Expected: []chan int{nil},
+			Options:  diff.Options{CompareReferences: true},
+			Diffs:    []string{"[0]: " + diff.FormatValue(chanA) + " != chan int(nil)"},
+		},
+		{
+			Name:     "with CompareReferences: funcs that are not nil",
+			Actual:   []func(){fn},
+			Expected: []func(){func() {}},
+			Options:  diff.Options{CompareReferences: true},
+		},
+		{
+			Name:     "with CompareReferences: nil func",
+			Actual:   []func(){fn},
+			Expected: []func(){nil},
+			Options:  diff.Options{CompareReferences: true},
+			Diffs:    []string{"[0]: " + diff.FormatValue(fn) + " != func()(nil)"},
+		},
+		{
+			Name:     "with CompareReferences: same unsafe pointers",
+			Actual:   []unsafe.Pointer{pointerX},
+			Expected: []unsafe.Pointer{pointerX},
+			Options:  diff.Options{CompareReferences: true},
+		},
+		{
+			Name:     "with CompareReferences: different unsafe pointers",
+			Actual:   []unsafe.Pointer{pointerX},
+			Expected: []unsafe.Pointer{pointerY},
+			Options:  diff.Options{CompareReferences: true},
+			Diffs:    []string{"[0]: " + diff.FormatValue(pointerX) + " != " + diff.FormatValue(pointerY)},
+		},
+		{
+			Name:     "nil slice does not equal empty slice by default",
+			Actual:   Person{Tags: nil},
+			Expected: Person{Tags: []string{}},
+			Diffs:    []string{"Tags: []string(nil) != []string{}"},
+		},
+		{
+			Name:     "nil map does not equal empty map by default",
+			Actual:   Person{Meta: map[string]string{}},
+			Expected: Person{Meta: nil},
+			Diffs:    []string{"Meta: map[string]string{} != map[string]string(nil)"},
+		},
+		{
+			Name:     "with NilEqualsEmpty: nil slices and maps equal empty slices and maps",
+			Actual:   Person{Tags: nil, Meta: map[string]string{}},
+			Expected: Person{Tags: []string{}, Meta: nil},
+			Options:  diff.Options{NilEqualsEmpty: true},
+		},
+		{
+			Name:     "with NilEqualsEmpty: nil slices do not equal non empty slices",
+			Actual:   Person{Tags: nil},
+			Expected: Person{Tags: []string{"a"}},
+			Options:  diff.Options{NilEqualsEmpty: true},
+			Diffs:    []string{`Tags: []string(nil) != []string{"a"}`},
+		},
+		{
+			Name:     "with IgnoredFields",
+			Actual:   Person{Name: "John", Age: 20, UpdatedAt: now, Friend: &Person{Name: "Bob", Age: 30}},
+			Expected: Person{Name: "John", Age: 21, Friend: &Person{Name: "Bob", Age: 31}},
+			Options:  diff.Options{IgnoredFields: []string{"Age", "UpdatedAt"}},
+		},
+		{
+			Name:     "with IgnoreUnexported",
+			Actual:   Person{Name: "John", secret: "abc"},
+			Expected: Person{Name: "John", secret: "xyz"},
+			Options:  diff.Options{IgnoreUnexported: true},
+		},
+		{
+			Name:     "with ApproxFloats: floats within epsilon are equal",
+			Actual:   []interface{}{1.001, Item{Price: 2.5}, math.Inf(1), math.NaN()},
+			Expected: []interface{}{1.0, Item{Price: 2.499}, math.Inf(1), math.NaN()},
+			Options:  diff.Options{ApproxFloats: true, FloatEpsilon: 0.01},
+		},
+		{
+			Name:     "with ApproxFloats: floats not within epsilon are not equal",
+			Actual:   []interface{}{1.1, Item{Price: 2.5}, math.Inf(1), 1},
+			Expected: []interface{}{1.0, Item{Price: 2.4}, math.Inf(-1), 2},
+			Options:  diff.Options{ApproxFloats: true, FloatEpsilon: 0.01},
+			Diffs: []string{
+				"[0]: 1.1 != 1",
+				"[1].Price: 2.5 != 2.4",
+				"[2]: +Inf != -Inf",
+				"[3]: 1 != 2",
+			},
+		},
+		{
+			Name:     "with CompareTimesWithEqual: unexported times in different locations are equal",
+			Actual:   Event{at: now, history: []time.Time{now}},
+			Expected: Event{at: now.In(time.FixedZone("UTC-1", -60*60)), history: []time.Time{now.Local()}},
+			Options:  diff.Options{CompareTimesWithEqual: true},
+		},
+		{
+			Name:     "with CompareTimesWithEqual: monotonic clock readings are ignored",
+			Actual:   &Event{at: monotonic},
+			Expected: &Event{at: monotonic.Round(0)}, // Strips the monotonic clock reading
+			Options:  diff.Options{CompareTimesWithEqual: true},
+		},
+		{
+			Name:     "with CompareTimesWithEqual: unexported times in maps and interfaces are compared with Equal",
+			Actual:   Event{byName: map[string]interface{}{"created": now}},
+			Expected: Event{byName: map[string]interface{}{"created": now.Local()}},
+			Options:  diff.Options{CompareTimesWithEqual: true},
+		},
+		{
+			Name:     "with CompareTimesWithEqual: unexported times with monotonic clock readings that are not equal",
+			Actual:   &Event{at: monotonic},
+			Expected: &Event{at: monotonic.Add(time.Second)},
+			Options:  diff.Options{CompareTimesWithEqual: true},
+			Diffs:    []string{"at: " + monotonic.UTC().String() + " != " + monotonic.Add(time.Second).UTC().String()},
+		},
+		{
+			Name:     "with CompareTimesWithEqual: unexported times that are not equal",
+			Actual:   Event{at: now, history: []time.Time{now}},
+			Expected: Event{at: now.Add(time.Second), history: []time.Time{now.Add(time.Minute)}},
+			Options:  diff.Options{CompareTimesWithEqual: true},
+			Diffs: []string{
+				"at: 2020-01-02 03:04:05 +0000 UTC != 2020-01-02 03:04:06 +0000 UTC",
+				"history[0]: 2020-01-02 03:04:05 +0000 UTC != 2020-01-02 03:05:05 +0000 UTC",
+			},
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+
+		differences := diff.Compare(entry.Actual, entry.Expected, entry.Options)
+		ensure(formatDifferences(differences)).Equals(entry.Diffs, ensuring.NilEqualsEmpty())
+	})
+}
+
+func TestCompareConcurrently(t *testing.T) {
+	ensure := ensure.New(t)
+
+	var wg sync.WaitGroup
+	results := make([][]diff.Difference, 100)
+
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			opts := diff.Options{IgnoreUnexported: i%2 == 0}
+			results[i] = diff.Compare(Person{secret: "abc"}, Person{secret: "xyz"}, opts)
+		}()
+	}
+
+	wg.Wait()
+
+	for i, result := range results {
+		if i%2 == 0 {
+			ensure(result).IsEmpty()
+		} else {
+			ensure(result).Equals([]diff.Difference{{Path: "secret", Actual: `"abc"`, Expected: `"xyz"`}})
+		}
+	}
+}
+
+func TestSortedMapKeys(t *testing.T) {
+	ensure := ensure.New(t)
+
+	keys := diff.SortedMapKeys(reflect.ValueOf(map[string]int{"c": 3, "a": 1, "b": 2}))
+
+	formatted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formatted = append(formatted, key.String())
+	}
+
+	ensure(formatted).Equals([]string{"a", "b", "c"})
+}
+
+func formatDifferences(differences []diff.Difference) []string {
+	formatted := make([]string, 0, len(differences))
+	for _, difference := range differences {
+		formatted = append(formatted, difference.String())
+	}
+
+	return formatted
+}
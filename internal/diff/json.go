@@ -0,0 +1,135 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const jsonRootPath = "$"
+
+//nolint:gochecknoglobals // Only read internally.
+var jsonIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// JSON returns the differences between two decoded JSON values, with paths like $.items[2].price.
+// The values are expected to be decoded using a [json.Decoder] with UseNumber enabled, so numbers
+// are compared exactly, and numbers with different representations (for example, 1 and 1.0) are equal.
+// If no differences are returned, the values are equal.
+func JSON(actual, expected interface{}) []Difference {
+	differences := []Difference{}
+	compareJSON(&differences, jsonRootPath, actual, expected)
+	return differences
+}
+
+func compareJSON(differences *[]Difference, path string, actual, expected interface{}) {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		if actualValue, ok := actual.(map[string]interface{}); ok {
+			compareJSONObjects(differences, path, actualValue, expectedValue)
+			return
+		}
+
+	case []interface{}:
+		if actualValue, ok := actual.([]interface{}); ok {
+			compareJSONArrays(differences, path, actualValue, expectedValue)
+			return
+		}
+
+	case json.Number:
+		if actualValue, ok := actual.(json.Number); ok && jsonNumbersEqual(actualValue, expectedValue) {
+			return
+		}
+
+	default:
+		if actual == expected {
+			return
+		}
+	}
+
+	*differences = append(*differences, Difference{
+		Path:     path,
+		Actual:   formatJSON(actual),
+		Expected: formatJSON(expected),
+	})
+}
+
+func compareJSONObjects(differences *[]Difference, path string, actual, expected map[string]interface{}) {
+	keys := make([]string, 0, len(actual)+len(expected))
+	for key := range actual {
+		keys = append(keys, key)
+	}
+
+	for key := range expected {
+		if _, ok := actual[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyPath := jsonKeyPath(path, key)
+		actualValue, actualOK := actual[key]
+		expectedValue, expectedOK := expected[key]
+
+		switch {
+		case !actualOK:
+			*differences = append(*differences, Difference{Path: keyPath, Actual: missingKey, Expected: formatJSON(expectedValue)})
+		case !expectedOK:
+			*differences = append(*differences, Difference{Path: keyPath, Actual: formatJSON(actualValue), Expected: missingKey})
+		default:
+			compareJSON(differences, keyPath, actualValue, expectedValue)
+		}
+	}
+}
+
+func compareJSONArrays(differences *[]Difference, path string, actual, expected []interface{}) {
+	for i := range max(len(actual), len(expected)) {
+		indexPath := path + "[" + strconv.Itoa(i) + "]"
+
+		switch {
+		case i >= len(actual):
+			*differences = append(*differences, Difference{Path: indexPath, Actual: missingElement, Expected: formatJSON(expected[i])})
+		case i >= len(expected):
+			*differences = append(*differences, Difference{Path: indexPath, Actual: formatJSON(actual[i]), Expected: missingElement})
+		default:
+			compareJSON(differences, indexPath, actual[i], expected[i])
+		}
+	}
+}
+
+func jsonNumbersEqual(actual, expected json.Number) bool {
+	if actual == expected {
+		return true
+	}
+
+	actualRat, actualOK := new(big.Rat).SetString(actual.String())
+	expectedRat, expectedOK := new(big.Rat).SetString(expected.String())
+	return actualOK && expectedOK && actualRat.Cmp(expectedRat) == 0
+}
+
+func jsonKeyPath(path, key string) string {
+	if jsonIdentifierRegexp.MatchString(key) {
+		return path + "." + key
+	}
+
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+func formatJSON(value interface{}) string {
+	var b bytes.Buffer
+
+	// HTML characters are not escaped, so strings are shown as written
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return err.Error() // Shouldn't be possible, since the value was decoded from JSON
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,94 @@
+package diff_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+func TestJSON(t *testing.T) {
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name     string
+		Actual   string
+		Expected string
+		Diffs    []string
+	}{
+		{
+			Name:     "equal objects with different key order",
+			Actual:   `{"a": 1, "b": [true, null]}`,
+			Expected: `{"b": [true, null], "a": 1}`,
+		},
+		{
+			Name:     "numbers with different representations",
+			Actual:   `{"a": 1, "b": 100}`,
+			Expected: `{"a": 1.0, "b": 1e2}`,
+		},
+		{
+			Name:     "large numbers are compared exactly",
+			Actual:   `{"id": 9007199254740993}`,
+			Expected: `{"id": 9007199254740992}`,
+			Diffs:    []string{"$.id: 9007199254740993 != 9007199254740992"},
+		},
+		{
+			Name:     "nested paths",
+			Actual:   `{"items": [{"price": 1}, {"price": 2}, {"price": 3}]}`,
+			Expected: `{"items": [{"price": 1}, {"price": 2}, {"price": 3.5}]}`,
+			Diffs:    []string{"$.items[2].price: 3 != 3.5"},
+		},
+		{
+			Name:     "keys that are not identifiers",
+			Actual:   `{"first name": "John", "<tag>": 1}`,
+			Expected: `{"first name": "Sam", "<tag>": 2}`,
+			Diffs:    []string{`$["<tag>"]: 1 != 2`, `$["first name"]: "John" != "Sam"`},
+		},
+		{
+			Name:     "missing keys",
+			Actual:   `{"a": 1, "b": 2}`,
+			Expected: `{"a": 1, "c": {"d": 3}}`,
+			Diffs:    []string{"$.b: 2 != <missing key>", `$.c: <missing key> != {"d":3}`},
+		},
+		{
+			Name:     "missing elements",
+			Actual:   `[1]`,
+			Expected: `[1, "two", null]`,
+			Diffs:    []string{`$[1]: <missing element> != "two"`, "$[2]: <missing element> != null"},
+		},
+		{
+			Name:     "different types",
+			Actual:   `{"a": "1", "b": [], "c": null}`,
+			Expected: `{"a": 1, "b": {}, "c": false}`,
+			Diffs:    []string{`$.a: "1" != 1`, "$.b: [] != {}", "$.c: null != false"},
+		},
+		{
+			Name:     "different root values",
+			Actual:   `"abc"`,
+			Expected: `"xyz"`,
+			Diffs:    []string{`$: "abc" != "xyz"`},
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+
+		differences := diff.JSON(decodeJSON(entry.Actual), decodeJSON(entry.Expected))
+		ensure(formatDifferences(differences)).Equals(entry.Diffs, ensuring.NilEqualsEmpty())
+	})
+}
+
+func decodeJSON(str string) interface{} {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(str)))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		panic(err)
+	}
+
+	return decoded
+}
@@ -0,0 +1,232 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// contextLines is the number of unchanged lines shown around each change.
+	contextLines = 3
+
+	noNewlineAtEnd = `\ No newline at end of file`
+
+	// maxLCSCells limits the size of the table used to find the longest common subsequence of the changed lines.
+	// Above it, the changed lines are all removed and then added, instead of being aligned.
+	maxLCSCells = 1 << 20
+)
+
+type lineOp struct {
+	kind byte // ' ' when unchanged, '-' when only in actual, or '+' when only in expected
+	line string
+}
+
+type hunk struct {
+	ops []lineOp
+	end int // Index in the full list of ops after the last op in the hunk
+
+	actualStart, actualCount     int
+	expectedStart, expectedCount int
+}
+
+// Lines returns a unified diff of the lines in actual and expected. Lines only in actual are prefixed
+// with "-", and lines only in expected are prefixed with "+". Each hunk includes up to three unchanged
+// lines of context. An empty string is returned if actual and expected are equal.
+func Lines(actual, expected string) string {
+	if actual == expected {
+		return ""
+	}
+
+	ops := diffLines(splitLines(actual), splitLines(expected))
+
+	var b strings.Builder
+	for _, h := range buildHunks(ops) {
+		writeHunk(&b, h)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// splitLines splits str into lines, keeping the trailing newline of each line.
+// This allows a missing newline at the end of the string to be reported as a difference.
+func splitLines(str string) []string {
+	lines := strings.SplitAfter(str, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines returns the operations to turn actual into expected.
+// Lines shared by the start and end of both are trimmed before the changed lines in between are aligned.
+func diffLines(actual, expected []string) []lineOp {
+	prefix := 0
+	for prefix < len(actual) && prefix < len(expected) && actual[prefix] == expected[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(actual)-prefix && suffix < len(expected)-prefix &&
+		actual[len(actual)-1-suffix] == expected[len(expected)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]lineOp, 0, len(actual)+len(expected)-prefix-suffix)
+	for _, line := range actual[:prefix] {
+		ops = append(ops, lineOp{kind: ' ', line: line})
+	}
+
+	ops = appendChangedLines(ops, actual[prefix:len(actual)-suffix], expected[prefix:len(expected)-suffix])
+
+	for _, line := range actual[len(actual)-suffix:] {
+		ops = append(ops, lineOp{kind: ' ', line: line})
+	}
+
+	return ops
+}
+
+// appendChangedLines finds the longest common subsequence of lines, and appends the operations to turn actual into
+// expected. If the table would be too large, the lines are not aligned, so all of actual is removed and all of
+// expected is added.
+func appendChangedLines(ops []lineOp, actual, expected []string) []lineOp {
+	if (len(actual)+1)*(len(expected)+1) > maxLCSCells {
+		for _, line := range actual {
+			ops = append(ops, lineOp{kind: '-', line: line})
+		}
+
+		for _, line := range expected {
+			ops = append(ops, lineOp{kind: '+', line: line})
+		}
+
+		return ops
+	}
+
+	// Lengths of the longest common subsequences of actual[i:] and expected[j:]
+	lcs := make([][]int, len(actual)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(expected)+1)
+	}
+
+	for i := len(actual) - 1; i >= 0; i-- {
+		for j := len(expected) - 1; j >= 0; j-- {
+			if actual[i] == expected[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(actual) || j < len(expected) {
+		switch {
+		case i < len(actual) && j < len(expected) && actual[i] == expected[j]:
+			ops = append(ops, lineOp{kind: ' ', line: actual[i]})
+			i++
+			j++
+		case j == len(expected) || (i < len(actual) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, lineOp{kind: '-', line: actual[i]})
+			i++
+		default:
+			ops = append(ops, lineOp{kind: '+', line: expected[j]})
+			j++
+		}
+	}
+
+	return ops
+}
+
+// buildHunks groups changed lines with their surrounding context, merging hunks whose context overlaps.
+func buildHunks(ops []lineOp) []*hunk {
+	hunks := []*hunk{}
+
+	var current *hunk
+	actualLine, expectedLine := 1, 1
+
+	for i, op := range ops {
+		if op.kind != ' ' {
+			if current != nil && i-current.end <= 2*contextLines {
+				current.appendOps(ops[current.end : i+1])
+			} else {
+				if current != nil {
+					current.appendTrailingContext(ops)
+				}
+
+				// Any lines of leading context are unchanged, since otherwise they would be part of the previous hunk
+				leading := min(i, contextLines)
+				current = &hunk{
+					end:           i - leading,
+					actualStart:   actualLine - leading,
+					expectedStart: expectedLine - leading,
+				}
+
+				current.appendOps(ops[current.end : i+1])
+				hunks = append(hunks, current)
+			}
+		}
+
+		if op.kind != '+' {
+			actualLine++
+		}
+
+		if op.kind != '-' {
+			expectedLine++
+		}
+	}
+
+	if current != nil {
+		current.appendTrailingContext(ops)
+	}
+
+	return hunks
+}
+
+func (h *hunk) appendOps(ops []lineOp) {
+	for _, op := range ops {
+		h.ops = append(h.ops, op)
+		h.end++
+
+		if op.kind != '+' {
+			h.actualCount++
+		}
+
+		if op.kind != '-' {
+			h.expectedCount++
+		}
+	}
+}
+
+func (h *hunk) appendTrailingContext(ops []lineOp) {
+	// Any lines of trailing context are unchanged, since otherwise they would be part of this hunk
+	trailing := min(len(ops)-h.end, contextLines)
+	h.appendOps(ops[h.end : h.end+trailing])
+}
+
+func writeHunk(b *strings.Builder, h *hunk) {
+	fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(h.actualStart, h.actualCount), hunkRange(h.expectedStart, h.expectedCount))
+
+	for _, op := range h.ops {
+		b.WriteByte(op.kind)
+		b.WriteString(strings.TrimSuffix(op.line, "\n"))
+		b.WriteString("\n")
+
+		// Unchanged lines are not marked, since the missing newline is not part of the difference
+		if op.kind != ' ' && !strings.HasSuffix(op.line, "\n") {
+			b.WriteString(noNewlineAtEnd + "\n")
+		}
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// An empty range refers to the line before where it would have started
+		return fmt.Sprintf("%d,0", start-1)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start, count)
+}
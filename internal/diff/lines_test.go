@@ -0,0 +1,155 @@
+package diff_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+func TestLines(t *testing.T) {
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name     string
+		Actual   string
+		Expected string
+		Diff     string
+	}{
+		{
+			Name:     "equal",
+			Actual:   "a\nb\nc\n",
+			Expected: "a\nb\nc\n",
+			Diff:     "",
+		},
+		{
+			Name:     "changed line",
+			Actual:   "a\nb\nc\n",
+			Expected: "a\nx\nc\n",
+			Diff: "@@ -1,3 +1,3 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"+x\n" +
+				" c",
+		},
+		{
+			Name:     "added and removed lines",
+			Actual:   "a\nb\nc\n",
+			Expected: "a\nc\nd\n",
+			Diff: "@@ -1,3 +1,3 @@\n" +
+				" a\n" +
+				"-b\n" +
+				" c\n" +
+				"+d",
+		},
+		{
+			Name:     "empty actual",
+			Actual:   "",
+			Expected: "a\nb\n",
+			Diff: "@@ -0,0 +1,2 @@\n" +
+				"+a\n" +
+				"+b",
+		},
+		{
+			Name:     "missing newline at end",
+			Actual:   "a\nb",
+			Expected: "a\nb\n",
+			Diff: "@@ -1,2 +1,2 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"\\ No newline at end of file\n" +
+				"+b",
+		},
+		{
+			Name:     "unchanged last line without newline at end",
+			Actual:   "a\nb",
+			Expected: "x\nb",
+			Diff: "@@ -1,2 +1,2 @@\n" +
+				"-a\n" +
+				"+x\n" +
+				" b",
+		},
+		{
+			Name:     "changes far apart are split into hunks with context",
+			Actual:   "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			Expected: "1\nx\n3\n4\n5\n6\n7\n8\n9\n10\ny\n12\n",
+			Diff: "@@ -1,5 +1,5 @@\n" +
+				" 1\n" +
+				"-2\n" +
+				"+x\n" +
+				" 3\n" +
+				" 4\n" +
+				" 5\n" +
+				"@@ -8,5 +8,5 @@\n" +
+				" 8\n" +
+				" 9\n" +
+				" 10\n" +
+				"-11\n" +
+				"+y\n" +
+				" 12",
+		},
+		{
+			Name:     "changes close together are merged into one hunk",
+			Actual:   "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			Expected: "1\nx\n3\n4\n5\n6\n7\ny\n9\n",
+			Diff: "@@ -1,9 +1,9 @@\n" +
+				" 1\n" +
+				"-2\n" +
+				"+x\n" +
+				" 3\n" +
+				" 4\n" +
+				" 5\n" +
+				" 6\n" +
+				" 7\n" +
+				"-8\n" +
+				"+y\n" +
+				" 9",
+		},
+		{
+			Name:     "large input with a change in the middle",
+			Actual:   numberedLines(1, 5000),
+			Expected: numberedLines(1, 2499) + "x\n" + numberedLines(2501, 5000),
+			Diff: "@@ -2497,7 +2497,7 @@\n" +
+				" 2497\n" +
+				" 2498\n" +
+				" 2499\n" +
+				"-2500\n" +
+				"+x\n" +
+				" 2501\n" +
+				" 2502\n" +
+				" 2503",
+		},
+		{
+			Name:     "large changed section is removed and added without being aligned",
+			Actual:   numberedLines(1, 1100),
+			Expected: "x\n" + numberedLines(1, 1099) + "y\n",
+			Diff: "@@ -1,1100 +1,1101 @@\n" +
+				prefixLines("-", numberedLines(1, 1100)) +
+				prefixLines("+", "x\n"+numberedLines(1, 1099)) +
+				"+y",
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+
+		ensure(diff.Lines(entry.Actual, entry.Expected)).Equals(entry.Diff)
+	})
+}
+
+// numberedLines returns the numbers from start to end, each on its own line.
+func numberedLines(start, end int) string {
+	var b strings.Builder
+	for i := start; i <= end; i++ {
+		b.WriteString(strconv.Itoa(i) + "\n")
+	}
+
+	return b.String()
+}
+
+func prefixLines(prefix, str string) string {
+	return prefix + strings.ReplaceAll(strings.TrimSuffix(str, "\n"), "\n", "\n"+prefix) + "\n"
+}
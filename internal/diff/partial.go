@@ -0,0 +1,21 @@
+package diff
+
+import "reflect"
+
+// ComparePartial returns the differences between actual and expected, like [Compare], except only the parts
+// of actual that are provided in expected are compared:
+//   - Struct fields that are zero in expected are skipped.
+//   - Map keys that are only in actual are skipped.
+//   - Slices must have the same length, but their elements are compared partially.
+//
+// The paths that were skipped are also returned, in the order they were found.
+func ComparePartial(actual, expected interface{}, opts Options) ([]Difference, []string) {
+	c := &comparer{
+		opts:    opts,
+		visited: make(map[visit]struct{}),
+		partial: true,
+	}
+
+	c.compare(reflect.ValueOf(actual), reflect.ValueOf(expected))
+	return c.diffs, c.skipped
+}
@@ -0,0 +1,92 @@
+package diff_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/internal/diff"
+)
+
+func TestComparePartial(t *testing.T) {
+	ensure := ensure.New(t)
+
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	table := []struct {
+		Name     string
+		Actual   interface{}
+		Expected interface{}
+		Diffs    []string
+		Skipped  []string
+	}{
+		{
+			Name:     "matching provided fields",
+			Actual:   Person{Name: "John", Age: 30, UpdatedAt: now, secret: "abc"},
+			Expected: Person{Name: "John"},
+			Skipped:  []string{"Age", "UpdatedAt", "Tags", "Meta", "Friend", "secret"},
+		},
+		{
+			Name:     "different provided fields",
+			Actual:   Person{Name: "John", Age: 30},
+			Expected: Person{Name: "Sam", Age: 30},
+			Diffs:    []string{`Name: "John" != "Sam"`},
+			Skipped:  []string{"UpdatedAt", "Tags", "Meta", "Friend", "secret"},
+		},
+		{
+			Name:     "nested structs through pointers",
+			Actual:   Person{Friend: &Person{Name: "Sam", Age: 20}},
+			Expected: Person{Friend: &Person{Age: 21}},
+			Diffs:    []string{"Friend.Age: 20 != 21"},
+			Skipped:  []string{"Name", "Age", "UpdatedAt", "Tags", "Meta", "Friend.Name", "Friend.UpdatedAt", "Friend.Tags", "Friend.Meta", "Friend.Friend", "Friend.secret", "secret"},
+		},
+		{
+			Name:     "maps only compare expected keys",
+			Actual:   map[string]Item{"a": {Price: 1}, "b": {Price: 2}},
+			Expected: map[string]Item{"b": {Price: 3}, "c": {}},
+			Diffs:    []string{`["b"].Price: 2 != 3`, `["c"]: <missing key> != diff_test.Item{}`},
+			Skipped:  []string{`["a"]`},
+		},
+		{
+			Name: "slices compare elements partially",
+			Actual: Customer{Orders: []Order{
+				{ID: 1, Items: map[string]Item{"a": {Price: 1}}},
+				{ID: 2, Items: map[string]Item{"b": {Price: 2}}},
+			}},
+			Expected: Customer{Orders: []Order{
+				{ID: 1},
+				{Items: map[string]Item{"b": {Price: 3}}},
+			}},
+			Diffs:   []string{`Orders[1].Items["b"].Price: 2 != 3`},
+			Skipped: []string{"Orders[0].Items", "Orders[1].ID"},
+		},
+		{
+			Name:     "slices with different lengths",
+			Actual:   Person{Tags: []string{"a", "b"}},
+			Expected: Person{Tags: []string{"a"}},
+			Diffs:    []string{`Tags[1]: "b" != <missing element>`},
+			Skipped:  []string{"Name", "Age", "UpdatedAt", "Meta", "Friend", "secret"},
+		},
+		{
+			Name:     "zero values outside of struct fields are compared",
+			Actual:   []int{1, 2},
+			Expected: []int{0, 2},
+			Diffs:    []string{"[0]: 1 != 0"},
+		},
+		{
+			Name:     "different types",
+			Actual:   Person{},
+			Expected: Item{Price: 1},
+			Diffs:    []string{"diff_test.Person{} != diff_test.Item{Price:1}"},
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+
+		differences, skipped := diff.ComparePartial(entry.Actual, entry.Expected, diff.Options{})
+		ensure(formatDifferences(differences)).Equals(entry.Diffs, ensuring.NilEqualsEmpty())
+		ensure(skipped).Equals(entry.Skipped, ensuring.NilEqualsEmpty())
+	})
+}
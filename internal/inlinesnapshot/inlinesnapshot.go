@@ -0,0 +1,210 @@
+// Package inlinesnapshot rewrites the string literals passed to inline snapshot assertions in Go source files.
+package inlinesnapshot
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	// ErrCallNotFound is returned when the assertion call cannot be found on the provided line.
+	ErrCallNotFound = errors.New("cannot find the call in the source file")
+
+	// ErrNotStringLiteral is returned when the snapshot argument is not a string literal.
+	ErrNotStringLiteral = errors.New("the snapshot argument must be a string literal")
+
+	// ErrConflictingUpdates is returned when the same call is updated with different values.
+	ErrConflictingUpdates = errors.New("the call was updated with different values, so it cannot be used in a loop or table driven test")
+)
+
+// Updater rewrites inline snapshots in source files. It is safe to use concurrently.
+//
+// Updates are always applied to the original contents of each file, so line numbers reported by
+// the runtime stay valid after earlier updates change the number of lines in the file.
+type Updater struct {
+	mu    sync.Mutex
+	files map[string]*sourceFile
+}
+
+type sourceFile struct {
+	original []byte
+	mode     os.FileMode
+	updates  map[int]string // Keyed by line
+}
+
+// NewUpdater creates an Updater.
+func NewUpdater() *Updater {
+	return &Updater{files: map[string]*sourceFile{}}
+}
+
+// Update replaces the string literal argument of the call to funcName at the provided line of the file at path,
+// with a literal containing snapshot.
+func (u *Updater) Update(path string, line int, funcName, snapshot string) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	file, err := u.loadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if existing, ok := file.updates[line]; ok {
+		if existing != snapshot {
+			return fmt.Errorf("%s:%d: %w", path, line, ErrConflictingUpdates)
+		}
+
+		return nil
+	}
+
+	file.updates[line] = snapshot
+
+	updated, err := file.render(funcName)
+	if err != nil {
+		delete(file.updates, line)
+		return fmt.Errorf("%s:%d: %w", path, line, err)
+	}
+
+	return os.WriteFile(path, updated, file.mode)
+}
+
+func (u *Updater) loadFile(path string) (*sourceFile, error) {
+	if file, ok := u.files[path]; ok {
+		return file, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &sourceFile{
+		original: original,
+		mode:     info.Mode().Perm(),
+		updates:  map[int]string{},
+	}
+
+	u.files[path] = file
+	return file, nil
+}
+
+type replacement struct {
+	start, end int
+	literal    string
+}
+
+func (f *sourceFile) render(funcName string) ([]byte, error) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", f.original, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	replacements := make([]replacement, 0, len(f.updates))
+	for line, snapshot := range f.updates {
+		call := findCall(fset, parsed, funcName, line)
+		if call == nil {
+			return nil, ErrCallNotFound
+		}
+
+		if len(call.Args) != 1 {
+			return nil, ErrNotStringLiteral
+		}
+
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return nil, ErrNotStringLiteral
+		}
+
+		replacements = append(replacements, replacement{
+			start:   fset.Position(lit.Pos()).Offset,
+			end:     fset.Position(lit.End()).Offset,
+			literal: Literal(snapshot),
+		})
+	}
+
+	// Apply the replacements from the end of the file, so the earlier offsets are not changed
+	sort.Slice(replacements, func(i, j int) bool { return replacements[i].start > replacements[j].start })
+
+	updated := append([]byte{}, f.original...)
+	for _, r := range replacements {
+		updated = append(updated[:r.start], append([]byte(r.literal), updated[r.end:]...)...)
+	}
+
+	return updated, nil
+}
+
+// findCall finds the innermost call to a method or function named funcName that spans the provided line.
+func findCall(fset *token.FileSet, file *ast.File, funcName string, line int) *ast.CallExpr {
+	var found *ast.CallExpr
+	foundSpan := 0
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || calledName(call) != funcName {
+			return true
+		}
+
+		startLine := fset.Position(call.Pos()).Line
+		endLine := fset.Position(call.End()).Line
+		if line < startLine || line > endLine {
+			return true
+		}
+
+		if span := endLine - startLine; found == nil || span < foundSpan {
+			found = call
+			foundSpan = span
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func calledName(call *ast.CallExpr) string {
+	switch fn := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		return fn.Sel.Name
+	case *ast.Ident:
+		return fn.Name
+	default:
+		return ""
+	}
+}
+
+// Literal formats snapshot as a Go string literal. Raw string literals are used when possible.
+// Multi-line snapshots start and end with a newline, so they are easier to read; see [Normalize].
+func Literal(snapshot string) string {
+	if strings.Contains(snapshot, "\n") {
+		snapshot = "\n" + snapshot + "\n"
+	}
+
+	if strings.ContainsAny(snapshot, "`\r\x00") || !utf8.ValidString(snapshot) {
+		return strconv.Quote(snapshot)
+	}
+
+	return "`" + snapshot + "`"
+}
+
+// Normalize removes the newlines surrounding multi-line snapshots formatted by [Literal].
+func Normalize(snapshot string) string {
+	if len(snapshot) >= 2 && strings.HasPrefix(snapshot, "\n") && strings.HasSuffix(snapshot, "\n") {
+		return snapshot[1 : len(snapshot)-1]
+	}
+
+	return snapshot
+}
@@ -0,0 +1,216 @@
+package inlinesnapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/JosiahWitt/ensure"
+	"github.com/JosiahWitt/ensure/ensuring"
+	"github.com/JosiahWitt/ensure/internal/inlinesnapshot"
+)
+
+const exampleSource = `package example_test
+
+func TestExample(t *testing.T) {
+	ensure := ensure.New(t)
+
+	ensure("abc").MatchesInlineSnapshot("")
+	ensure(123).MatchesInlineSnapshot(` + "`" + `
+old
+value
+` + "`" + `)
+	ensure(456).MatchesInlineSnapshot(snapshot)
+	ensure(789).Equals(789)
+}
+`
+
+func TestUpdaterUpdate(t *testing.T) {
+	ensure := ensure.New(t)
+
+	ensure.Run("updates multiple snapshots using the original line numbers", func(ensure ensuring.E) {
+		path := writeSourceFile(ensure, exampleSource)
+		updater := inlinesnapshot.NewUpdater()
+
+		err := updater.Update(path, 6, "MatchesInlineSnapshot", "line 1\nline 2")
+		ensure(err).IsNotError()
+
+		// The first update added lines, but the original line number of the second call is used
+		err = updater.Update(path, 7, "MatchesInlineSnapshot", "123")
+		ensure(err).IsNotError()
+
+		ensure(readSourceFile(ensure, path)).Equals(`package example_test
+
+func TestExample(t *testing.T) {
+	ensure := ensure.New(t)
+
+	ensure("abc").MatchesInlineSnapshot(` + "`" + `
+line 1
+line 2
+` + "`" + `)
+	ensure(123).MatchesInlineSnapshot(` + "`123`" + `)
+	ensure(456).MatchesInlineSnapshot(snapshot)
+	ensure(789).Equals(789)
+}
+`)
+	})
+
+	ensure.Run("finds calls that span multiple lines", func(ensure ensuring.E) {
+		path := writeSourceFile(ensure, exampleSource)
+		updater := inlinesnapshot.NewUpdater()
+
+		err := updater.Update(path, 9, "MatchesInlineSnapshot", "new value")
+		ensure(err).IsNotError()
+		ensure(readSourceFile(ensure, path)).Contains("ensure(123).MatchesInlineSnapshot(`new value`)\n")
+	})
+
+	ensure.Run("allows the same update multiple times", func(ensure ensuring.E) {
+		path := writeSourceFile(ensure, exampleSource)
+		updater := inlinesnapshot.NewUpdater()
+
+		ensure(updater.Update(path, 6, "MatchesInlineSnapshot", "abc")).IsNotError()
+		ensure(updater.Update(path, 6, "MatchesInlineSnapshot", "abc")).IsNotError()
+		ensure(readSourceFile(ensure, path)).Contains("ensure(\"abc\").MatchesInlineSnapshot(`abc`)\n")
+	})
+
+	ensure.Run("with conflicting updates", func(ensure ensuring.E) {
+		path := writeSourceFile(ensure, exampleSource)
+		updater := inlinesnapshot.NewUpdater()
+
+		ensure(updater.Update(path, 6, "MatchesInlineSnapshot", "abc")).IsNotError()
+
+		err := updater.Update(path, 6, "MatchesInlineSnapshot", "xyz")
+		ensure(err).IsError(inlinesnapshot.ErrConflictingUpdates)
+	})
+
+	ensure.Run("when argument is not a string literal", func(ensure ensuring.E) {
+		path := writeSourceFile(ensure, exampleSource)
+		updater := inlinesnapshot.NewUpdater()
+
+		err := updater.Update(path, 11, "MatchesInlineSnapshot", "456")
+		ensure(err).IsError(inlinesnapshot.ErrNotStringLiteral)
+		ensure(readSourceFile(ensure, path)).Equals(exampleSource)
+	})
+
+	ensure.Run("when call is not found", func(ensure ensuring.E) {
+		path := writeSourceFile(ensure, exampleSource)
+		updater := inlinesnapshot.NewUpdater()
+
+		err := updater.Update(path, 12, "MatchesInlineSnapshot", "789")
+		ensure(err).IsError(inlinesnapshot.ErrCallNotFound)
+		ensure(readSourceFile(ensure, path)).Equals(exampleSource)
+	})
+
+	ensure.Run("when file does not exist", func(ensure ensuring.E) {
+		updater := inlinesnapshot.NewUpdater()
+
+		err := updater.Update(filepath.Join(ensure.T().TempDir(), "missing.go"), 1, "MatchesInlineSnapshot", "abc")
+		ensure(err).IsError(os.ErrNotExist)
+	})
+}
+
+func TestLiteral(t *testing.T) {
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name     string
+		Snapshot string
+		Literal  string
+	}{
+		{
+			Name:     "empty",
+			Snapshot: "",
+			Literal:  "``",
+		},
+		{
+			Name:     "single line",
+			Snapshot: `abc "xyz"`,
+			Literal:  "`abc \"xyz\"`",
+		},
+		{
+			Name:     "multiple lines",
+			Snapshot: "abc\nxyz",
+			Literal:  "`\nabc\nxyz\n`",
+		},
+		{
+			Name:     "with backticks",
+			Snapshot: "abc `xyz`",
+			Literal:  `"abc ` + "`xyz`" + `"`,
+		},
+		{
+			Name:     "with backticks and multiple lines",
+			Snapshot: "abc\n`xyz`",
+			Literal:  `"\nabc\n` + "`xyz`" + `\n"`,
+		},
+		{
+			Name:     "with carriage returns",
+			Snapshot: "abc\r\nxyz",
+			Literal:  `"\nabc\r\nxyz\n"`,
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+
+		literal := inlinesnapshot.Literal(entry.Snapshot)
+		ensure(literal).Equals(entry.Literal)
+
+		// The literal should round trip back to the snapshot
+		unquoted, err := strconv.Unquote(literal)
+		ensure(err).IsNotError()
+		ensure(inlinesnapshot.Normalize(unquoted)).Equals(entry.Snapshot)
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	ensure := ensure.New(t)
+
+	table := []struct {
+		Name       string
+		Snapshot   string
+		Normalized string
+	}{
+		{
+			Name:       "single line",
+			Snapshot:   "abc",
+			Normalized: "abc",
+		},
+		{
+			Name:       "multiple lines surrounded by newlines",
+			Snapshot:   "\nabc\nxyz\n",
+			Normalized: "abc\nxyz",
+		},
+		{
+			Name:       "multiple lines not surrounded by newlines",
+			Snapshot:   "abc\nxyz",
+			Normalized: "abc\nxyz",
+		},
+		{
+			Name:       "single newline",
+			Snapshot:   "\n",
+			Normalized: "\n",
+		},
+	}
+
+	ensure.RunTableByIndex(table, func(ensure ensuring.E, i int) {
+		entry := table[i]
+		ensure(inlinesnapshot.Normalize(entry.Snapshot)).Equals(entry.Normalized)
+	})
+}
+
+func writeSourceFile(ensure ensuring.E, contents string) string {
+	path := filepath.Join(ensure.T().TempDir(), "example_test.go")
+
+	err := os.WriteFile(path, []byte(contents), 0o600)
+	ensure(err).IsNotError()
+
+	return path
+}
+
+func readSourceFile(ensure ensuring.E, path string) string {
+	contents, err := os.ReadFile(path)
+	ensure(err).IsNotError()
+
+	return string(contents)
+}
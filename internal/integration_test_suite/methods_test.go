@@ -46,6 +46,22 @@ func sharedEnsureRunTests(t *testing.T, prepare func(ensure ensuring.E) func(str
 		// Shows that ensure.Run executed with a nested scope
 		assertEq(t, name, namePrefix+"/some_name")
 	})
+
+	t.Run("soft scope can be used in nested scope", func(t *testing.T) {
+		namePrefix := t.Name()
+		ensure := ensure.New(t)
+
+		name := ""
+		run := prepare(ensure)
+		run("some name", func(ensure ensuring.E) {
+			ensure.Soft(func(ensure ensuring.E) {
+				ensure(true).IsTrue()
+				name = ensure.T().Name()
+			})
+		})
+
+		assertEq(t, name, namePrefix+"/some_name")
+	})
 }
 
 func TestRunParallel(t *testing.T) {
@@ -190,6 +206,32 @@ func sharedEnsureRunTableByIndexTests(t *testing.T, prepare func(ensure ensuring
 
 		assertEq(t, loggedMessages, []string{"hello world", "hello universe"})
 	})
+
+	t.Run("soft scope can be used in entries", func(t *testing.T) {
+		ensure := ensure.New(t)
+
+		table := []struct {
+			Name     string
+			Input    string
+			Expected string
+		}{
+			{
+				Name:     "first one",
+				Input:    "abc",
+				Expected: "abc",
+			},
+		}
+
+		runTableByIndex := prepare(ensure)
+		runTableByIndex(table, func(ensure ensuring.E, i int) {
+			entry := table[i]
+
+			ensure.Soft(func(ensure ensuring.E) {
+				ensure(entry.Input).Equals(entry.Expected)
+				ensure(entry.Input).IsNotEmpty()
+			})
+		})
+	})
 }
 
 func TestT(t *testing.T) {
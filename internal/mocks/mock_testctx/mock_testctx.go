@@ -5,6 +5,7 @@
 package mock_testctx
 
 import (
+	"github.com/JosiahWitt/ensure/ensuring"
 	"github.com/JosiahWitt/ensure/internal/testctx"
 	"github.com/kr/pretty"
 	"go.uber.org/mock/gomock"
@@ -521,6 +522,15 @@ func (mr *MockSyncableContextMockRecorder) T() *gomock.Call {
 }
 
 func wrapMatcher(input interface{}) gomock.Matcher {
+	if matcher, ok := input.(ensuring.Matcher); ok {
+		return gomock.GotFormatterAdapter(
+			gomock.GotFormatterFunc(func(got interface{}) string {
+				return pretty.Sprint(got) + "\nMismatch:\n" + matcher.Explain(got)
+			}),
+			matcher,
+		)
+	}
+
 	if matcher, ok := input.(gomock.Matcher); ok {
 		return matcher
 	}
@@ -541,7 +551,12 @@ func wrapMatcher(input interface{}) gomock.Matcher {
 
 	return gomock.GotFormatterAdapter(
 		gomock.GotFormatterFunc(func(got interface{}) string {
-			return pretty.Sprint(got)
+			formatted := pretty.Sprint(got)
+			if diff := ensuring.Diff(got, input); diff != "" {
+				formatted += "\nDiff:\n" + diff
+			}
+
+			return formatted
 		}),
 		matcher,
 	)